@@ -0,0 +1,473 @@
+package ggl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+const (
+	bindSourceArgument = "argument"
+	bindSourceRoot     = "root"
+	bindSourceContext  = "context"
+	bindSourceParent   = "source"
+)
+
+type converter func(value interface{}) (reflect.Value, error)
+
+type convertError struct {
+	source   string
+	path     []interface{}
+	expected reflect.Type
+	value    interface{}
+	reason   string
+}
+
+func (err *convertError) Error() string {
+	message := fmt.Sprintf("go-graph-loader: cannot convert %v into %v", describeValue(err.value), err.expected)
+	if err.reason != "" {
+		message = fmt.Sprintf("go-graph-loader: %s", err.reason)
+	}
+
+	if len(err.path) > 0 {
+		path := make([]string, 0, len(err.path))
+		for _, key := range err.path {
+			path = append(path, fmt.Sprintf("%v", key))
+		}
+		message += " at " + strings.Join(path, ".")
+	}
+	return message
+}
+
+func (err *convertError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"code":     errorCodeBadUserInput,
+		"expected": err.expected.String(),
+	}
+
+	source := bindSourceArgument
+	switch err.source {
+	case bindSourceRoot:
+		extensions["code"] = errorCodeInvalidRootValue
+		source = bindSourceRoot
+	case bindSourceContext:
+		extensions["code"] = errorCodeInvalidContextValue
+		source = bindSourceContext
+	case bindSourceParent:
+		extensions["code"] = errorCodeInvalidSourceValue
+		source = bindSourceParent
+	}
+
+	if len(err.path) > 0 {
+		extensions[source] = err.path[0]
+		extensions["path"] = err.path
+	}
+	return extensions
+}
+
+func describeValue(value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+	return fmt.Sprintf("%v (%T)", value, value)
+}
+
+func withConvertPath(err error, key interface{}) error {
+	if convertErr, ok := err.(*convertError); ok {
+		convertErr.path = append([]interface{}{key}, convertErr.path...)
+		return convertErr
+	}
+	return err
+}
+
+func withBindPath(err error, source string, key interface{}) error {
+	err = withConvertPath(err, key)
+	if convertErr, ok := err.(*convertError); ok {
+		convertErr.source = source
+	}
+	return err
+}
+
+func (loader *manager) missingContextValue(name string, expected reflect.Type) error {
+	if loader.contextValueError != nil {
+		return loader.contextValueError(name)
+	}
+
+	return &convertError{
+		source:   bindSourceContext,
+		path:     []interface{}{name},
+		expected: expected,
+		reason:   "context value is required",
+	}
+}
+
+type fieldConverter struct {
+	index        int
+	graphKey     string
+	defaultValue interface{}
+	hasDefault   bool
+	required     bool
+	contextKey   interface{}
+	convert      converter
+}
+
+func (arg fieldConverter) bind(request reflect.Value, source string, value interface{}, ok bool) error {
+	field := cleanPtrValue(request).Field(arg.index)
+	if !ok {
+		if arg.required {
+			return &convertError{
+				source:   source,
+				path:     []interface{}{arg.graphKey},
+				expected: field.Type(),
+				reason:   source + " value is required",
+			}
+		}
+		return nil
+	}
+
+	fieldValue, err := arg.convert(value)
+	if err != nil {
+		return withBindPath(err, source, arg.graphKey)
+	}
+	field.Set(fieldValue)
+	return nil
+}
+
+func (loader *manager) converterByType(t reflect.Type) converter {
+	if convert, ok := loader.converters[t]; ok {
+		return convert
+	}
+
+	// placeholder for recursive types, it will be replaced after built
+	loader.converters[t] = func(value interface{}) (reflect.Value, error) {
+		return loader.converters[t](value)
+	}
+
+	convert := loader.buildConverter(t)
+	loader.converters[t] = func(value interface{}) (reflect.Value, error) {
+		if literal, ok := value.(defaultLiteral); ok {
+			value = literal.value
+		}
+
+		if value == nil {
+			return reflect.Zero(t), nil
+		}
+
+		if parseErr, ok := value.(*scalarParseError); ok {
+			return reflect.Value{}, &convertError{expected: t, reason: parseErr.err.Error()}
+		}
+
+		val := reflect.ValueOf(value)
+		if val.Type().AssignableTo(t) {
+			return val, nil
+		}
+
+		if val.Kind() == reflect.Ptr && t.Kind() != reflect.Ptr {
+			if val.IsNil() {
+				return reflect.Zero(t), nil
+			}
+			return loader.converters[t](val.Elem().Interface())
+		}
+		return convert(value)
+	}
+	return loader.converters[t]
+}
+
+func (loader *manager) buildConverter(t reflect.Type) converter {
+	if enum, ok := loader.enumByType(t); ok && t.Kind() != reflect.Ptr {
+		return func(value interface{}) (reflect.Value, error) {
+			if name, ok := value.(string); ok {
+				if enumValue := enum.ParseValue(name); enumValue != nil {
+					return reflect.ValueOf(enumValue), nil
+				}
+			}
+			return convertPrimitive(t, value)
+		}
+	}
+
+	switch t {
+	case rawMessageType:
+		return func(value interface{}) (reflect.Value, error) {
+			bytes, err := json.Marshal(value)
+			if err != nil {
+				return reflect.Value{}, &convertError{expected: t, value: value, reason: err.Error()}
+			}
+			return reflect.ValueOf(json.RawMessage(bytes)), nil
+		}
+	case durationType:
+		return convertDuration
+	case bigIntType:
+		return func(value interface{}) (reflect.Value, error) {
+			if bigIntValue, ok := parseBigInt(value); ok {
+				return reflect.ValueOf(*bigIntValue), nil
+			}
+			return reflect.Value{}, &convertError{expected: t, value: value}
+		}
+	case bigFloatType:
+		return func(value interface{}) (reflect.Value, error) {
+			if bigFloatValue, ok := parseDecimal(value); ok {
+				return reflect.ValueOf(*bigFloatValue), nil
+			}
+			return reflect.Value{}, &convertError{expected: t, value: value}
+		}
+	}
+
+	if parse, ok := loader.scalarParsers[t]; ok {
+		return func(value interface{}) (reflect.Value, error) {
+			parsed, err := parse(value)
+			if err != nil {
+				return reflect.Value{}, &convertError{expected: t, value: value, reason: err.Error()}
+			}
+
+			for parsed.Kind() == reflect.Ptr && parsed.Type() != t {
+				if parsed.IsNil() {
+					return reflect.Zero(t), nil
+				}
+				parsed = parsed.Elem()
+			}
+			return parsed, nil
+		}
+	}
+
+	if loader.isMarshalerScalarType(t) {
+		return func(value interface{}) (reflect.Value, error) {
+			val, err := parseMarshalerValue(t, value)
+			if err != nil {
+				return reflect.Value{}, &convertError{expected: t, value: value, reason: err.Error()}
+			}
+			return val, nil
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		convertElem := loader.converterByType(t.Elem())
+		return func(value interface{}) (reflect.Value, error) {
+			elemValue, err := convertElem(value)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			ptrValue := reflect.New(t.Elem())
+			ptrValue.Elem().Set(elemValue)
+			return ptrValue, nil
+		}
+
+	case reflect.Interface:
+		return func(value interface{}) (reflect.Value, error) {
+			return reflect.Value{}, &convertError{expected: t, value: value}
+		}
+
+	case reflect.Struct:
+		if t == timeType {
+			return convertTime
+		}
+		return loader.structConverter(t)
+
+	case reflect.Slice, reflect.Array:
+		convertElem := loader.converterByType(t.Elem())
+		return func(value interface{}) (reflect.Value, error) {
+			val := reflect.ValueOf(value)
+			if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+				return reflect.Value{}, &convertError{expected: t, value: value}
+			}
+
+			listValue := reflect.New(t).Elem()
+			if t.Kind() == reflect.Slice {
+				listValue.Set(reflect.MakeSlice(t, val.Len(), val.Len()))
+			} else if val.Len() > t.Len() {
+				return reflect.Value{}, &convertError{
+					expected: t,
+					value:    value,
+					reason:   fmt.Sprintf("expected at most %v items for %v but got %v", t.Len(), t, val.Len()),
+				}
+			}
+
+			for index := 0; index < val.Len(); index++ {
+				elemValue, err := convertElem(val.Index(index).Interface())
+				if err != nil {
+					return reflect.Value{}, withConvertPath(err, index)
+				}
+				listValue.Index(index).Set(elemValue)
+			}
+			return listValue, nil
+		}
+
+	case reflect.Map:
+		convertKey := loader.converterByType(t.Key())
+		convertElem := loader.converterByType(t.Elem())
+		return func(value interface{}) (reflect.Value, error) {
+			val := reflect.ValueOf(value)
+			if val.Kind() != reflect.Map {
+				return reflect.Value{}, &convertError{expected: t, value: value}
+			}
+
+			mapValue := reflect.MakeMapWithSize(t, val.Len())
+			iter := val.MapRange()
+			for iter.Next() {
+				keyValue, err := convertKey(iter.Key().Interface())
+				if err != nil {
+					return reflect.Value{}, withConvertPath(err, iter.Key().Interface())
+				}
+
+				elemValue, err := convertElem(iter.Value().Interface())
+				if err != nil {
+					return reflect.Value{}, withConvertPath(err, iter.Key().Interface())
+				}
+				mapValue.SetMapIndex(keyValue, elemValue)
+			}
+			return mapValue, nil
+		}
+	}
+
+	return func(value interface{}) (reflect.Value, error) {
+		return convertPrimitive(t, value)
+	}
+}
+
+func (loader *manager) structConverter(t reflect.Type) converter {
+	fields := make([]fieldConverter, 0)
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		graphKey := graphNameFromTag(structField.Tag, loader.graphKeyTag)
+		if graphKey == "" || graphKey == "-" {
+			continue
+		}
+
+		defaultValue, hasDefault := loader.defaultValueByStructField(structField)
+		fields = append(fields, fieldConverter{
+			index:        i,
+			graphKey:     graphKey,
+			defaultValue: defaultValue,
+			hasDefault:   hasDefault,
+			convert:      loader.converterByType(structField.Type),
+		})
+	}
+
+	return func(value interface{}) (reflect.Value, error) {
+		objectValue, ok := value.(map[string]interface{})
+		if !ok {
+			return reflect.Value{}, &convertError{expected: t, value: value}
+		}
+
+		structValue := reflect.New(t).Elem()
+		for _, field := range fields {
+			val, ok := objectValue[field.graphKey]
+			if !ok && field.hasDefault {
+				val, ok = field.defaultValue, true
+			}
+
+			if !ok {
+				continue
+			}
+
+			fieldValue, err := field.convert(val)
+			if err != nil {
+				return reflect.Value{}, withConvertPath(err, field.graphKey)
+			}
+			structValue.Field(field.index).Set(fieldValue)
+		}
+		return structValue, nil
+	}
+}
+
+func convertTime(value interface{}) (reflect.Value, error) {
+	switch value := value.(type) {
+	case string:
+		timeValue, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return reflect.Value{}, &convertError{expected: timeType, value: value, reason: err.Error()}
+		}
+		return reflect.ValueOf(timeValue), nil
+	}
+	return reflect.Value{}, &convertError{expected: timeType, value: value}
+}
+
+func convertDuration(value interface{}) (reflect.Value, error) {
+	if stringValue, ok := value.(string); ok {
+		durationValue, err := time.ParseDuration(stringValue)
+		if err != nil {
+			return reflect.Value{}, &convertError{expected: durationType, value: value, reason: err.Error()}
+		}
+		return reflect.ValueOf(durationValue), nil
+	}
+	return convertPrimitive(durationType, value)
+}
+
+func convertPrimitive(t reflect.Type, value interface{}) (reflect.Value, error) {
+	val := reflect.ValueOf(value)
+	if isNumberKind(val.Kind()) && isNumberKind(t.Kind()) {
+		return convertNumber(t, val)
+	}
+
+	if val.Kind() == t.Kind() && val.Type().ConvertibleTo(t) {
+		return val.Convert(t), nil
+	}
+	return reflect.Value{}, &convertError{expected: t, value: value}
+}
+
+func convertNumber(t reflect.Type, val reflect.Value) (reflect.Value, error) {
+	field := reflect.New(t).Elem()
+	overflow := false
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case val.CanInt():
+			overflow = field.OverflowInt(val.Int())
+		case val.CanUint():
+			overflow = val.Uint() > math.MaxInt64 || field.OverflowInt(int64(val.Uint()))
+		default:
+			overflow = val.Float() != math.Trunc(val.Float()) || val.Float() < math.MinInt64 || val.Float() >= math.MaxInt64 || field.OverflowInt(int64(val.Float()))
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch {
+		case val.CanInt():
+			overflow = val.Int() < 0 || field.OverflowUint(uint64(val.Int()))
+		case val.CanUint():
+			overflow = field.OverflowUint(val.Uint())
+		default:
+			overflow = val.Float() != math.Trunc(val.Float()) || val.Float() < 0 || val.Float() >= math.MaxUint64 || field.OverflowUint(uint64(val.Float()))
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if val.CanFloat() {
+			overflow = field.OverflowFloat(val.Float())
+		}
+	}
+
+	if overflow {
+		return reflect.Value{}, &convertError{
+			expected: t,
+			value:    val.Interface(),
+			reason:   fmt.Sprintf("value %v cannot be represented as %v", val.Interface(), t),
+		}
+	}
+	return val.Convert(t), nil
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func (loader *manager) enumByType(t reflect.Type) (*graphql.Enum, bool) {
+	if _, ok := loader.enumValues[t]; !ok && !t.Implements(enumerableType) {
+		return nil, false
+	}
+
+	enum, ok := loader.graphByTypes(t).(*graphql.Enum)
+	return enum, ok
+}
@@ -0,0 +1,227 @@
+package ggl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+func (loader *manager) defaultValueByStructField(field reflect.StructField) (interface{}, bool) {
+	rawValue, ok := field.Tag.Lookup(defaultKeyTag)
+	if !ok {
+		return nil, false
+	}
+
+	var value interface{}
+	var err error
+	if timeScalar, ok := loader.timeScalarByField(field); ok {
+		value = timeScalar.(*graphql.Scalar).ParseValue(rawValue)
+		if value == nil {
+			err = fmt.Errorf("unable to parse %q as %v", rawValue, timeScalar.Name())
+		}
+	} else {
+		value, err = loader.parseDefaultValue(field.Type, rawValue)
+	}
+
+	if err != nil {
+		panicWithFootprint(
+			definitionTypeDefaultValue,
+			field.Type,
+			nil,
+			errInvalidDefaultValue+": "+err.Error(),
+		)
+	}
+	return value, true
+}
+
+// defaultLiteral is the published default value of scalar serialized as string, graphql-go is printing it
+// with `String` as string literal while the converter is binding the parsed value.
+type defaultLiteral struct {
+	literal string
+	value   interface{}
+}
+
+func (literal defaultLiteral) String() string {
+	return literal.literal
+}
+
+// publishedDefaultValue is returning the default value which can be printed as valid literal by introspection,
+// enum, list and input object defaults are not published because graphql-go is printing them as string.
+func publishedDefaultValue(graphType graphql.Type, value interface{}) interface{} {
+	if nonNull, ok := graphType.(*graphql.NonNull); ok {
+		graphType = nonNull.OfType
+	}
+
+	scalar, ok := graphType.(*graphql.Scalar)
+	if !ok || value == nil {
+		return nil
+	}
+
+	serialized := scalar.Serialize(value)
+	if number, ok := serialized.(json.Number); ok {
+		if intValue, err := number.Int64(); err == nil {
+			return int(intValue)
+		}
+		return defaultLiteral{literal: number.String(), value: value}
+	}
+
+	switch serializedValue := reflect.ValueOf(serialized); {
+	case !serializedValue.IsValid():
+		return nil
+	case serializedValue.Kind() == reflect.Bool:
+		return serializedValue.Bool()
+	case serializedValue.CanInt():
+		return int(serializedValue.Int())
+	case serializedValue.CanUint() && serializedValue.Uint() <= math.MaxInt64:
+		return int(serializedValue.Uint())
+	case serializedValue.CanFloat():
+		return serializedValue.Float()
+	case serializedValue.Kind() == reflect.String:
+		if stringValue, ok := value.(string); ok && stringValue == serializedValue.String() {
+			return stringValue
+		}
+		return defaultLiteral{literal: serializedValue.String(), value: value}
+	}
+	return nil
+}
+
+func (loader *manager) parseDefaultValue(t reflect.Type, rawValue string) (interface{}, error) {
+	cleanType := cleanPtrType(t)
+	graphType := loader.graphInputByTypes(cleanType)
+
+	switch graphType := graphType.(type) {
+	case *graphql.Enum:
+		value := graphType.ParseValue(strings.TrimSpace(rawValue))
+		if value == nil {
+			return nil, fmt.Errorf("%q is not a value of enum %v", rawValue, graphType.Name())
+		}
+		return value, nil
+
+	case *graphql.List:
+		listValue, err := defaultListValue(rawValue)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, 0, len(listValue))
+		for _, item := range listValue {
+			value, err := loader.parseDefaultValue(cleanType.Elem(), item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+
+	case *graphql.InputObject:
+		objectValue := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(rawValue), &objectValue); err != nil {
+			return nil, err
+		}
+		return objectValue, nil
+	}
+
+	if graphType == jsonScalarObjectFunc {
+		var value interface{}
+		if err := json.Unmarshal([]byte(rawValue), &value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+
+	if isBuiltinScalarType(cleanType) {
+		if value := graphType.(*graphql.Scalar).ParseValue(rawValue); value != nil {
+			return value, nil
+		}
+		return nil, fmt.Errorf("unable to parse %q as %v", rawValue, graphType.Name())
+	}
+
+	switch cleanType.Kind() {
+	case reflect.Bool:
+		return strconv.ParseBool(rawValue)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(rawValue, 10, 64)
+		return int(value), err
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.ParseUint(rawValue, 10, 64)
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.ParseFloat(rawValue, 64)
+
+	case reflect.String:
+		return rawValue, nil
+
+	case reflect.Slice, reflect.Array:
+		listValue, err := defaultListValue(rawValue)
+		if err != nil {
+			return nil, err
+		}
+
+		value := reflect.New(cleanType).Elem()
+		if cleanType.Kind() == reflect.Slice {
+			value.Set(reflect.MakeSlice(cleanType, len(listValue), len(listValue)))
+		} else if len(listValue) > cleanType.Len() {
+			return nil, fmt.Errorf("expected at most %v items but got %v", cleanType.Len(), len(listValue))
+		}
+
+		convertElem := loader.converterByType(cleanType.Elem())
+		for index, item := range listValue {
+			itemValue, err := loader.parseDefaultValue(cleanType.Elem(), item)
+			if err != nil {
+				return nil, err
+			}
+
+			elemValue, err := convertElem(itemValue)
+			if err != nil {
+				return nil, err
+			}
+			value.Index(index).Set(elemValue)
+		}
+		return value.Interface(), nil
+	}
+
+	if scalar, ok := graphType.(*graphql.Scalar); ok {
+		if value := scalar.ParseValue(rawValue); value != nil {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to parse %q as %v", rawValue, t)
+}
+
+func defaultListValue(rawValue string) ([]string, error) {
+	rawValue = strings.TrimSpace(rawValue)
+	if rawValue == "" {
+		return []string{}, nil
+	}
+
+	if !strings.HasPrefix(rawValue, "[") {
+		listValue := strings.Split(rawValue, ",")
+		for index, item := range listValue {
+			listValue[index] = strings.TrimSpace(item)
+		}
+		return listValue, nil
+	}
+
+	jsonValue := make([]json.RawMessage, 0)
+	if err := json.Unmarshal([]byte(rawValue), &jsonValue); err != nil {
+		return nil, err
+	}
+
+	listValue := make([]string, 0, len(jsonValue))
+	for _, item := range jsonValue {
+		var stringValue string
+		if err := json.Unmarshal(item, &stringValue); err == nil {
+			listValue = append(listValue, stringValue)
+			continue
+		}
+		listValue = append(listValue, string(item))
+	}
+	return listValue, nil
+}
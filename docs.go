@@ -0,0 +1,144 @@
+package ggl
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"reflect"
+	"strings"
+)
+
+func (loader *manager) parseDocs(pkgPath string, dir string) error {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.GenDecl:
+					loader.parseTypeDocs(pkgPath, decl)
+				case *ast.FuncDecl:
+					if decl.Recv == nil || len(decl.Recv.List) == 0 {
+						continue
+					}
+					receiver := receiverTypeName(decl.Recv.List[0].Type)
+					loader.setDoc(pkgPath+"."+receiver+"."+decl.Name.Name, decl.Doc)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (loader *manager) parseTypeDocs(pkgPath string, decl *ast.GenDecl) {
+	if decl.Tok != token.TYPE {
+		return
+	}
+
+	for _, spec := range decl.Specs {
+		typeSpec := spec.(*ast.TypeSpec)
+		typeDoc := typeSpec.Doc
+		if typeDoc == nil && len(decl.Specs) == 1 {
+			typeDoc = decl.Doc
+		}
+		typeKey := pkgPath + "." + typeSpec.Name.Name
+		loader.setDoc(typeKey, typeDoc)
+
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		for _, field := range structType.Fields.List {
+			fieldDoc := field.Doc
+			if fieldDoc == nil {
+				fieldDoc = field.Comment
+			}
+			for _, name := range field.Names {
+				loader.setDoc(typeKey+"."+name.Name, fieldDoc)
+			}
+		}
+	}
+}
+
+func (loader *manager) setDoc(key string, doc *ast.CommentGroup) {
+	if doc == nil {
+		return
+	}
+
+	paragraphs := make([]string, 0)
+	for _, paragraph := range strings.Split(doc.Text(), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if strings.HasPrefix(paragraph, "Deprecated:") {
+			loader.deprecations[key] = strings.TrimSpace(strings.TrimPrefix(paragraph, "Deprecated:"))
+			continue
+		}
+
+		if paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+
+	if len(paragraphs) > 0 {
+		loader.docs[key] = strings.Join(paragraphs, "\n\n")
+	}
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(expr.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+func (loader *manager) typeDescription(t reflect.Type) string {
+	cleanType := cleanPtrType(t)
+	return loader.docs[cleanType.PkgPath()+"."+cleanType.Name()]
+}
+
+func (loader *manager) methodDescription(t reflect.Type, method string) string {
+	cleanType := cleanPtrType(t)
+	return loader.docs[cleanType.PkgPath()+"."+cleanType.Name()+"."+method]
+}
+
+func (loader *manager) fieldDescription(t reflect.Type, field reflect.StructField) string {
+	if description := field.Tag.Get(descriptionKeyTag); description != "" {
+		return description
+	}
+	return loader.methodDescription(t, field.Name)
+}
+
+func (loader *manager) methodDeprecation(t reflect.Type, method string) string {
+	cleanType := cleanPtrType(t)
+	return loader.deprecations[cleanType.PkgPath()+"."+cleanType.Name()+"."+method]
+}
+
+func (loader *manager) fieldDeprecation(t reflect.Type, field reflect.StructField) string {
+	if reason := field.Tag.Get(deprecatedKeyTag); reason != "" {
+		return reason
+	}
+	return loader.methodDeprecation(t, field.Name)
+}
+
+func deprecatedDescription(description string, reason string) string {
+	if reason == "" {
+		return description
+	}
+
+	if description == "" {
+		return "Deprecated: " + reason
+	}
+	return description + "\n\nDeprecated: " + reason
+}
@@ -3,18 +3,124 @@ package ggl
 import (
 	"log"
 	"reflect"
+	"regexp"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
 )
 
 const (
 	// definitions
-	definitionTypePreResolver    = "PRE_RESOLVER"
-	definitionTypeResolverMethod = "RESOLVER_METHOD"
+	definitionTypePreResolver        = "PRE_RESOLVER"
+	definitionTypeResolverMethod     = "RESOLVER_METHOD"
+	definitionTypeSubscriptionMethod = "SUBSCRIPTION_METHOD"
+	definitionTypeEnum               = "ENUM"
+	definitionTypeInterface          = "INTERFACE"
+	definitionTypeUnion              = "UNION"
+	definitionTypeDefaultValue       = "DEFAULT_VALUE"
+	definitionTypeContextKey         = "CONTEXT_KEY"
+	definitionTypeValidation         = "VALIDATION"
 
 	// errors
 	errInvalidMethodSignatureForPreResolverFunction   = "invalid method signature is using for pre resolver function"
 	errInvalidMethodSignatureForFieldResolverFunction = "invalid method signature is using for field resolver function"
+	errInvalidMethodSignatureForSubscriptionFunction  = "invalid method signature is using for subscription function"
+	errInvalidAbstractType                            = "invalid abstract type, expected go interface type"
+	errInvalidImplementation                          = "invalid implementation, the type is not implementing the go interface"
+	errInvalidDefaultValue                            = "invalid default value is using for the field"
+	errUnregisteredContextKey                         = "unregistered context key is using for the field, register it with RegisterContextKey before schema"
+	errInvalidValidationRule                          = "invalid validation rule is using for the field"
+	errInvalidEnumValues                              = "invalid enum values is using for enum type, expected map[string]T with enum type values"
+	errInvalidEnumDeprecation                         = "invalid enum deprecation is using for enum type, the name is not a value of enum type"
+
+	// error codes
+	errorCodeBadUserInput        = "BAD_USER_INPUT"
+	errorCodeInvalidRootValue    = "INVALID_ROOT_VALUE"
+	errorCodeInvalidContextValue = "INVALID_CONTEXT_VALUE"
+	errorCodeInvalidSourceValue  = "INVALID_SOURCE_VALUE"
+	errorCodeValidationFailed    = "VALIDATION_FAILED"
+)
+
+var (
+	invalidArgumentMessage = regexp.MustCompile(`^Argument "([^"]+)" has invalid value`)
+	invalidVariableMessage = regexp.MustCompile(`^Variable "\$([^"]+)" got invalid value`)
+	expectedTypeMessage    = regexp.MustCompile(`Expected type "([^"]+)"`)
 )
 
+type validationError struct {
+	err error
+}
+
+func (err *validationError) Error() string {
+	return err.err.Error()
+}
+
+func (err *validationError) Unwrap() error {
+	return err.err
+}
+
+func (err *validationError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": errorCodeValidationFailed}
+}
+
+func formatResultErrors(result *graphql.Result) *graphql.Result {
+	formattedErrors := make([]gqlerrors.FormattedError, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		if errs, ok := originalError(err).(multiError); ok {
+			for _, itemErr := range errs {
+				formattedErr := gqlerrors.FormattedError{
+					Message:   itemErr.Error(),
+					Locations: err.Locations,
+					Path:      err.Path,
+				}
+				if extendedErr, ok := itemErr.(gqlerrors.ExtendedError); ok {
+					formattedErr.Extensions = extendedErr.Extensions()
+				}
+				formattedErrors = append(formattedErrors, formattedErr)
+			}
+			continue
+		}
+		formattedErrors = append(formattedErrors, formatInputError(err))
+	}
+
+	if result.Errors != nil {
+		result.Errors = formattedErrors
+	}
+	return result
+}
+
+func originalError(err gqlerrors.FormattedError) error {
+	if locatedErr, ok := err.OriginalError().(*gqlerrors.Error); ok {
+		return locatedErr.OriginalError
+	}
+	return err.OriginalError()
+}
+
+func formatInputError(err gqlerrors.FormattedError) gqlerrors.FormattedError {
+	if err.Extensions != nil {
+		return err
+	}
+
+	extensions := map[string]interface{}{"code": errorCodeBadUserInput}
+	if match := invalidArgumentMessage.FindStringSubmatch(err.Message); match != nil {
+		extensions["argument"] = match[1]
+	} else if match := invalidVariableMessage.FindStringSubmatch(err.Message); match != nil {
+		extensions["variable"] = match[1]
+	} else {
+		return err
+	}
+
+	if match := expectedTypeMessage.FindStringSubmatch(err.Message); match != nil {
+		extensions["expected"] = match[1]
+	}
+	return gqlerrors.FormattedError{
+		Message:    err.Message,
+		Locations:  err.Locations,
+		Path:       err.Path,
+		Extensions: extensions,
+	}
+}
+
 func panicWithFootprint(
 	definitionType string,
 	object reflect.Type,
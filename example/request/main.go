@@ -31,8 +31,8 @@ func main() {
 			product(id: 1) {
 				info, 
 				name(
-					test: "[\"1\"]",
-					test2: "{\"a\":\"a\"}"
+					test: ["1"],
+					test2: {a: "a"}
 				),
 				price(multiply: 100), 
 				priceInteger,
@@ -15,7 +15,7 @@ func (exe executor) Query(query string) executor {
 	return exe
 }
 
-func (exe executor) Root(rootObject map[string]interface{}) executor {
+func (exe executor) Root(rootObject interface{}) executor {
 	exe.rootObject = rootObject
 	return exe
 }
@@ -25,12 +25,38 @@ func (exe executor) Variables(variablesValues map[string]interface{}) executor {
 	return exe
 }
 
-func (exe executor) Execute(ctx context.Context) *graphql.Result {
-	return graphql.Do(graphql.Params{
+func (exe executor) params(ctx context.Context) graphql.Params {
+	params := graphql.Params{
 		Context:        ctx,
 		Schema:         exe.schema,
 		RequestString:  exe.requestString,
-		RootObject:     exe.rootObject,
 		VariableValues: exe.variablesValues,
-	})
+	}
+
+	if exe.rootObject != nil {
+		params.Context = context.WithValue(params.Context, rootObjectContextKey{}, exe.rootObject)
+		params.RootObject, _ = exe.rootObject.(map[string]interface{})
+	}
+	return params
+}
+
+func (exe executor) Execute(ctx context.Context) *graphql.Result {
+	return formatResultErrors(graphql.Do(exe.params(ctx)))
+}
+
+func (exe executor) Subscribe(ctx context.Context) chan *graphql.Result {
+	results := graphql.Subscribe(exe.params(ctx))
+
+	formattedResults := make(chan *graphql.Result)
+	go func() {
+		defer close(formattedResults)
+		for result := range results {
+			select {
+			case formattedResults <- formatResultErrors(result):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return formattedResults
 }
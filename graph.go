@@ -21,12 +21,21 @@ var (
 		},
 	})
 
+	enumerableType = reflect.TypeOf(new(enumerable)).Elem()
+
 	goStringerScalarObjectFunc = graphql.NewScalar(graphql.ScalarConfig{
 		Name:        "GoStringer",
 		Description: "The `GoStringer` scalar type represents Stringer type.",
 		Serialize: func(value interface{}) interface{} {
-			stringer := value.(fmt.GoStringer)
-			return stringer.GoString()
+			val := reflect.ValueOf(value)
+			if !val.IsValid() || (val.Kind() == reflect.Ptr && val.IsNil()) {
+				return nil
+			}
+
+			if stringer, ok := value.(fmt.GoStringer); ok {
+				return stringer.GoString()
+			}
+			return fmt.Sprintf("%#v", value)
 		},
 	})
 )
@@ -46,8 +55,84 @@ func graphNameFromTag(tag reflect.StructTag, key string) string {
 	return strings.Split(tag.Get(key), ",")[0]
 }
 
-func (loader *manager) graphSchema(resolver interface{}) (graphql.Schema, error) {
-	rootQuery := graphql.Fields{}
+func graphOptionFromTag(tag reflect.StructTag, key string, option string) bool {
+	for _, tagOption := range strings.Split(tag.Get(key), ",")[1:] {
+		if strings.TrimSpace(tagOption) == option {
+			return true
+		}
+	}
+	return false
+}
+
+func (loader *manager) isNonNullField(field reflect.StructField) bool {
+	if graphOptionFromTag(field.Tag, loader.graphKeyTag, "required") {
+		return true
+	}
+
+	if graphOptionFromTag(field.Tag, loader.graphKeyTag, "nullable") || !loader.inferNonNull {
+		return false
+	}
+
+	return !isNullableKind(field.Type)
+}
+
+func isNullableKind(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// listElemType is wrapping the list element with non null when inferring non null and element can't be nil.
+func (loader *manager) listElemType(elemType reflect.Type, t graphql.Type) graphql.Type {
+	if !loader.inferNonNull || isNullableKind(elemType) {
+		return t
+	}
+	return nonNullType(t)
+}
+
+func nonNullType(t graphql.Type) graphql.Type {
+	if _, ok := t.(*graphql.NonNull); ok {
+		return t
+	}
+	return graphql.NewNonNull(t)
+}
+
+func (loader *manager) graphSchema() (graphql.Schema, error) {
+	schemaConfig := graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: loader.queryFields,
+		}),
+	}
+
+	if len(loader.mutationFields) > 0 {
+		schemaConfig.Mutation = graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Mutation",
+			Fields: loader.mutationFields,
+		})
+	}
+
+	for _, abstract := range loader.abstractList {
+		if !abstract.isUnion {
+			for _, implementation := range abstract.implementations {
+				schemaConfig.Types = append(schemaConfig.Types, loader.graphByTypes(implementation))
+			}
+		}
+	}
+
+	if len(loader.subscriptionFields) > 0 {
+		schemaConfig.Subscription = graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Subscription",
+			Fields: loader.subscriptionFields,
+		})
+	}
+	return graphql.NewSchema(schemaConfig)
+}
+
+func (loader *manager) graphRootFields(typeName string, resolver interface{}) graphql.Fields {
+	rootFields := graphql.Fields{}
 	val := reflect.ValueOf(resolver)
 	valType := reflect.TypeOf(resolver)
 
@@ -57,45 +142,172 @@ func (loader *manager) graphSchema(resolver interface{}) (graphql.Schema, error)
 
 		graphField := new(graphql.Field)
 		graphField.Name = methodDefinitionName
+		graphField.Description = loader.methodDescription(valType, methodDefinition.Name)
+		graphField.DeprecationReason = loader.methodDeprecation(valType, methodDefinition.Name)
 		graphField.Args, graphField.Type, graphField.Resolve = loader.graphResolverByMethod(&val, methodDefinition)
-		rootQuery[methodDefinitionName] = graphField
+		loader.registerArgumentDefaults(typeName, methodDefinitionName, methodDefinition)
+		rootFields[methodDefinitionName] = graphField
 	}
+	return rootFields
+}
 
-	return graphql.NewSchema(graphql.SchemaConfig{
-		Query: graphql.NewObject(graphql.ObjectConfig{
-			Name:   "Query",
-			Fields: rootQuery,
-		}),
-	})
+func (loader *manager) graphSubscriptionFields(resolver interface{}) graphql.Fields {
+	rootFields := graphql.Fields{}
+	val := reflect.ValueOf(resolver)
+	valType := reflect.TypeOf(resolver)
+
+	for i := 0; i < val.NumMethod(); i++ {
+		methodDefinition := valType.Method(i)
+		methodDefinitionName := strcase.ToLowerCamel(valType.Method(i).Name)
+
+		methodType := methodDefinition.Type
+		if methodType.NumOut() != 2 ||
+			(methodType.NumIn() != 2 && methodType.NumIn() != 3) ||
+			!checkIsContext(methodType.In(1)) ||
+			methodType.Out(0).Kind() != reflect.Chan ||
+			methodType.Out(0).ChanDir()&reflect.RecvDir == 0 {
+			panicWithFootprint(
+				definitionTypeSubscriptionMethod,
+				methodDefinition.Type.In(0),
+				methodDefinition.Func.Type(),
+				errInvalidMethodSignatureForSubscriptionFunction,
+			)
+		}
+
+		graphField := new(graphql.Field)
+		graphField.Name = methodDefinitionName
+		graphField.Description = loader.methodDescription(valType, methodDefinition.Name)
+		graphField.DeprecationReason = loader.methodDeprecation(valType, methodDefinition.Name)
+
+		var subscribe graphql.FieldResolveFn
+		graphField.Args, graphField.Type, subscribe = loader.graphResolverByMethod(&val, methodDefinition)
+		loader.registerArgumentDefaults("Subscription", methodDefinitionName, methodDefinition)
+		graphField.Subscribe = func(p graphql.ResolveParams) (interface{}, error) {
+			source, err := subscribe(p)
+			if err != nil {
+				return nil, err
+			}
+			return subscriptionEvents(p.Context, reflect.ValueOf(source)), nil
+		}
+		graphField.Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source, nil
+		}
+		rootFields[methodDefinitionName] = graphField
+	}
+	return rootFields
+}
+
+func subscriptionEvents(ctx context.Context, source reflect.Value) chan interface{} {
+	events := make(chan interface{})
+	go func() {
+		defer close(events)
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+			{Dir: reflect.SelectRecv, Chan: source},
+		}
+		for {
+			chosen, event, ok := reflect.Select(cases)
+			if chosen == 0 || !ok {
+				return
+			}
+
+			select {
+			case events <- event.Interface():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
 }
 
 func (loader *manager) graphResolverByMethod(root *reflect.Value, method reflect.Method) (graphql.FieldConfigArgument, graphql.Output, func(graphql.ResolveParams) (interface{}, error)) {
 	methodType := method.Type
 	graphArgs := graphql.FieldConfigArgument{}
-	graphLoaderArgs := make(map[string]string)
-	rootLoaderArgs := make(map[string]string)
+	graphLoaderArgs := make([]fieldConverter, 0)
+	rootLoaderArgs := make([]fieldConverter, 0)
+	contextLoaderArgs := make([]fieldConverter, 0)
+	parentArgLoaderArgs := make([]fieldConverter, 0)
+	sourceLoaderArgs := make([]fieldConverter, 0)
+	selectionArgs := make([]int, 0)
+	var validation *structValidation
 	if (method.Type.NumIn() == 3 || method.Type.NumIn() == 2) &&
 		method.Type.NumOut() == 2 &&
 		checkIsContext(method.Type.In(1)) {
 
 		if method.Type.NumIn() == 3 {
 			requestType := cleanPtrType(methodType.In(2))
+			validation = loader.validationByType(requestType)
 			for i := 0; i < requestType.NumField(); i++ {
 				field := requestType.Field(i)
+				if cleanPtrType(field.Type) == selectionType {
+					selectionArgs = append(selectionArgs, i)
+					continue
+				}
 
 				root := graphNameFromTag(field.Tag, loader.rootObjectKeyTag)
 				if root != "" {
-					rootLoaderArgs[root] = field.Name
+					rootLoaderArgs = append(rootLoaderArgs, fieldConverter{
+						index:    i,
+						graphKey: root,
+						required: graphOptionFromTag(field.Tag, loader.rootObjectKeyTag, "required"),
+						convert:  loader.converterByType(field.Type),
+					})
+				}
+
+				if name := graphNameFromTag(field.Tag, contextKeyTag); name != "" {
+					contextKey, ok := loader.contextKeys[name]
+					if !ok {
+						panicWithFootprint(
+							definitionTypeContextKey,
+							requestType,
+							nil,
+							errUnregisteredContextKey+": "+name,
+						)
+					}
+
+					contextLoaderArgs = append(contextLoaderArgs, fieldConverter{
+						index:      i,
+						graphKey:   name,
+						required:   graphOptionFromTag(field.Tag, contextKeyTag, "required"),
+						contextKey: contextKey,
+						convert:    loader.converterByType(field.Type),
+					})
+				}
+
+				if name := graphNameFromTag(field.Tag, parentArgKeyTag); name != "" {
+					parentArgLoaderArgs = append(parentArgLoaderArgs, fieldConverter{
+						index:    i,
+						graphKey: name,
+						required: graphOptionFromTag(field.Tag, parentArgKeyTag, "required"),
+						convert:  loader.converterByType(field.Type),
+					})
+				}
+
+				if name := graphNameFromTag(field.Tag, sourceKeyTag); name != "" {
+					sourceLoaderArgs = append(sourceLoaderArgs, fieldConverter{
+						index:    i,
+						graphKey: name,
+						required: graphOptionFromTag(field.Tag, sourceKeyTag, "required"),
+						convert:  loader.converterByType(field.Type),
+					})
 				}
 
 				gql := graphNameFromTag(field.Tag, loader.graphKeyTag)
 				if gql != "" && gql != "-" {
-					fn, ac := loader.graphArgumentConfigByStructField(field)
+					fn, ac := loader.graphArgumentConfigByStructField(requestType, field)
 					if ac == nil {
 						continue
 					}
 					graphArgs[fn] = ac
-					graphLoaderArgs[gql] = field.Name
+					defaultValue, hasDefault := loader.defaultValueByStructField(field)
+					graphLoaderArgs = append(graphLoaderArgs, fieldConverter{
+						index:        i,
+						graphKey:     gql,
+						defaultValue: defaultValue,
+						hasDefault:   hasDefault,
+						convert:      loader.converterByType(field.Type),
+					})
 				}
 			}
 		}
@@ -110,27 +322,23 @@ func (loader *manager) graphResolverByMethod(root *reflect.Value, method reflect
 	}
 
 	responseType := methodType.Out(0)
-	var graphOutput graphql.Output
-	var preResolver func(context.Context) context.Context
-	if root != nil {
-		resolver, fields := loader.graphFieldsByType(responseType)
-		preResolver = resolver
+	if responseType.Kind() == reflect.Chan {
+		responseType = responseType.Elem()
+	}
 
-		graphOutput = graphql.NewObject(graphql.ObjectConfig{
-			Name:   cleanPtrType(responseType).Name(),
-			Fields: fields,
-		})
-	} else {
-		graphOutput = loader.graphByTypes(responseType)
+	var preResolver resolver
+	if root != nil {
+		preResolver = loader.graphPreResolverByType(responseType)
 	}
 
+	graphOutput := loader.graphByTypes(responseType)
 	return graphArgs, graphOutput, func(p graphql.ResolveParams) (interface{}, error) {
 		rValues := make([]reflect.Value, 0)
 
 		if root != nil {
 			rValues = append(rValues, *root)
 		} else {
-			rValues = append(rValues, reflect.ValueOf(p.Source))
+			rValues = append(rValues, receiverValue(methodType.In(0), p.Source))
 		}
 
 		resolverCtx := p.Context
@@ -141,49 +349,87 @@ func (loader *manager) graphResolverByMethod(root *reflect.Value, method reflect
 
 		if method.Type.NumIn() == 3 {
 			request := reflect.New(cleanPtrType(methodType.In(2)))
-			for graphKey, goKey := range graphLoaderArgs {
-				if val, ok := p.Args[graphKey]; ok {
-					field := cleanPtrValue(request).FieldByName(goKey)
-					switch true {
-					case field.CanInt():
-						field.SetInt(reflect.ValueOf(val).Int())
-					case field.CanUint():
-						field.SetUint(reflect.ValueOf(val).Uint())
-					case field.CanFloat():
-						field.SetFloat(reflect.ValueOf(val).Float())
-					default:
-						field.Set(reflect.ValueOf(val))
-					}
+			for _, index := range selectionArgs {
+				field := cleanPtrValue(request).Field(index)
+				selection := loader.selectionByInfo(responseType, p.Info)
+				field.Set(convertToOriginalPointer(field.Type(), reflect.ValueOf(selection).Elem()))
+			}
+
+			arguments := make(map[string]interface{}, len(graphLoaderArgs))
+			for _, arg := range graphLoaderArgs {
+				val, ok := p.Args[arg.graphKey]
+				if !ok && arg.hasDefault {
+					val, ok = arg.defaultValue, true
+				}
+
+				if ok {
+					arguments[arg.graphKey] = val
+				}
+
+				if err := arg.bind(request, bindSourceArgument, val, ok); err != nil {
+					return nil, err
 				}
 			}
 
 			if len(rootLoaderArgs) > 0 {
-				rootObject := p.Info.RootValue.(map[string]interface{})
-				for graphKey, goKey := range rootLoaderArgs {
-					if val, ok := rootObject[graphKey]; ok {
-						field := cleanPtrValue(request).FieldByName(goKey)
-						switch true {
-						case field.CanInt():
-							field.SetInt(reflect.ValueOf(val).Int())
-						case field.CanUint():
-							field.SetUint(reflect.ValueOf(val).Uint())
-						case field.CanFloat():
-							field.SetFloat(reflect.ValueOf(val).Float())
-						default:
-							field.Set(reflect.ValueOf(val))
-						}
+				rootObject := p.Context.Value(rootObjectContextKey{})
+				if rootObject == nil {
+					rootObject = p.Info.RootValue
+				}
+
+				for _, arg := range rootLoaderArgs {
+					val, ok := loader.valueByPath(rootObject, arg.graphKey)
+					if err := arg.bind(request, bindSourceRoot, val, ok); err != nil {
+						return nil, err
 					}
 				}
 			}
 
-			if loader.validator != nil {
-				err := loader.validator.Validate(request.Interface())
-				if err != nil {
+			for _, arg := range parentArgLoaderArgs {
+				val, ok := loader.parentArgument(p.Info, arg.graphKey)
+				if err := arg.bind(request, bindSourceArgument, val, ok); err != nil {
+					return nil, err
+				}
+			}
+
+			for _, arg := range sourceLoaderArgs {
+				val, ok := loader.valueByPath(p.Source, arg.graphKey)
+				if err := arg.bind(request, bindSourceParent, val, ok); err != nil {
+					return nil, err
+				}
+			}
+
+			for _, arg := range contextLoaderArgs {
+				val := resolverCtx.Value(arg.contextKey)
+				if val == nil && arg.required {
+					return nil, loader.missingContextValue(arg.graphKey, cleanPtrValue(request).Field(arg.index).Type())
+				}
+
+				if err := arg.bind(request, bindSourceContext, val, val != nil); err != nil {
 					return nil, err
 				}
 			}
 
-			rValues = append(rValues, request)
+			errs := validation.validate(request, arguments, nil)
+			for _, validator := range loader.requestValidators[cleanPtrType(methodType.In(2))] {
+				errs = appendValidationError(errs, validator(resolverCtx, request.Interface(), p.Info))
+			}
+
+			if validator, ok := request.Interface().(contextValidator); ok {
+				errs = appendValidationError(errs, validator.Validate(resolverCtx))
+			}
+
+			if loader.validator != nil {
+				errs = appendValidationError(errs, loader.validator.Validate(request.Interface()))
+			}
+
+			if len(errs) == 1 {
+				return nil, errs[0]
+			} else if len(errs) > 1 {
+				return nil, errs
+			}
+
+			rValues = append(rValues, convertToOriginalPointer(methodType.In(2), request.Elem()))
 		}
 
 		rsp := method.Func.Call(rValues)
@@ -194,32 +440,56 @@ func (loader *manager) graphResolverByMethod(root *reflect.Value, method reflect
 	}
 }
 
-func (loader *manager) graphFieldByStructField(field reflect.StructField) (string, *graphql.Field) {
-	scalarType := loader.graphByTypes(field.Type)
+func (loader *manager) graphFieldByStructField(ownerType reflect.Type, field reflect.StructField) (string, *graphql.Field) {
 	graphKey := graphNameFromTag(field.Tag, loader.graphKeyTag)
 	if graphKey == "" || graphKey == "-" {
 		return "", nil
 	}
-	return graphKey, &graphql.Field{Name: field.Name, Type: scalarType}
-}
 
-func (loader *manager) graphArgumentConfigByStructField(field reflect.StructField) (string, *graphql.ArgumentConfig) {
+	loader.types.nameAnonymousField(ownerType, field)
 	scalarType := loader.graphByTypes(field.Type)
+	if timeScalar, ok := loader.timeScalarByField(field); ok {
+		scalarType = timeScalar
+	}
+	return graphKey, &graphql.Field{
+		Name:              field.Name,
+		Type:              scalarType,
+		Description:       loader.fieldDescription(ownerType, field),
+		DeprecationReason: loader.fieldDeprecation(ownerType, field),
+	}
+}
+
+func (loader *manager) graphArgumentConfigByStructField(ownerType reflect.Type, field reflect.StructField) (string, *graphql.ArgumentConfig) {
+	loader.types.nameAnonymousField(ownerType, field)
+	scalarType := loader.graphInputByTypes(field.Type)
 	graphKey := graphNameFromTag(field.Tag, loader.graphKeyTag)
 	if graphKey == "" || graphKey == "-" {
 		return "", nil
 	}
-	return graphKey, &graphql.ArgumentConfig{Type: scalarType}
+
+	if timeScalar, ok := loader.timeScalarByField(field); ok {
+		scalarType = timeScalar
+	}
+
+	defaultValue, hasDefault := loader.defaultValueByStructField(field)
+	if loader.isNonNullField(field) && !hasDefault {
+		scalarType = nonNullType(scalarType)
+	}
+	return graphKey, &graphql.ArgumentConfig{
+		Type:         scalarType,
+		DefaultValue: publishedDefaultValue(scalarType, defaultValue),
+		Description:  validationDescription(deprecatedDescription(loader.fieldDescription(ownerType, field), loader.fieldDeprecation(ownerType, field)), field),
+	}
 }
 
-func (loader *manager) graphFieldsByType(ptrType reflect.Type) (resolver, graphql.Fields) {
+func (loader *manager) graphFieldsByType(ptrType reflect.Type) graphql.Fields {
 	outputType := cleanPtrType(ptrType)
 	graphFields := graphql.Fields{}
 
 	reservedFields := make(map[string]*struct{})
 	for j := 0; j < outputType.NumField(); j++ {
 		field := outputType.Field(j)
-		fn, gf := loader.graphFieldByStructField(field)
+		fn, gf := loader.graphFieldByStructField(outputType, field)
 		if gf == nil {
 			continue
 		}
@@ -228,66 +498,138 @@ func (loader *manager) graphFieldsByType(ptrType reflect.Type) (resolver, graphq
 		if hasMethod {
 			reservedFields["GGL_"+field.Name] = nil
 			gf.Args, gf.Type, gf.Resolve = loader.graphResolverByMethod(nil, methodResolver)
+			loader.registerArgumentDefaults(loader.types.baseName(outputType), fn, methodResolver)
+			if gf.Description == "" {
+				gf.Description = loader.methodDescription(outputType, methodResolver.Name)
+			}
+			if gf.DeprecationReason == "" {
+				gf.DeprecationReason = loader.methodDeprecation(outputType, methodResolver.Name)
+			}
+		}
+
+		if loader.isNonNullField(field) {
+			gf.Type = nonNullType(gf.Type)
 		}
 		graphFields[fn] = gf
 	}
 
-	var preResolver resolver
 	for j := 0; j < ptrType.NumMethod(); j++ {
 		field := ptrType.Method(j)
-		if field.Name == "PreResolver" {
-			rfn := field.Func
-			if rfn.Type().NumOut() == 1 &&
-				rfn.Type().NumIn() == 2 &&
-				checkIsContext(rfn.Type().Out(0)) &&
-				checkIsContext(rfn.Type().In(1)) {
-				preResolver = func(ctx context.Context) context.Context {
-					rsp := rfn.Call([]reflect.Value{reflect.New(cleanPtrType(ptrType)), reflect.ValueOf(ctx)})
-					return rsp[0].Interface().(context.Context)
-				}
-			} else {
-				panicWithFootprint(
-					definitionTypePreResolver,
-					ptrType,
-					rfn.Type(),
-					errInvalidMethodSignatureForPreResolverFunction,
-				)
-			}
-		}
-
 		if strings.HasPrefix(field.Name, "GGL_") {
 			if _, ok := reservedFields[field.Name]; !ok {
 				graphName := strcase.ToLowerCamel(strings.TrimPrefix(field.Name, "GGL_"))
 				gf := new(graphql.Field)
 				gf.Name = field.Name
+				gf.Description = loader.methodDescription(outputType, field.Name)
+				gf.DeprecationReason = loader.methodDeprecation(outputType, field.Name)
 				methodResolver, hasMethod := ptrType.MethodByName(field.Name)
 				if hasMethod {
 					gf.Args, gf.Type, gf.Resolve = loader.graphResolverByMethod(nil, methodResolver)
+					loader.registerArgumentDefaults(loader.types.baseName(outputType), strcase.ToLowerCamel(graphName), methodResolver)
 				}
 				graphFields[strcase.ToLowerCamel(graphName)] = gf
 			}
 		}
 	}
 
-	return preResolver, graphFields
+	return graphFields
+}
+
+func (loader *manager) graphPreResolverByType(responseType reflect.Type) resolver {
+	ptrType := reflect.PtrTo(cleanPtrType(responseType))
+	field, hasMethod := ptrType.MethodByName("PreResolver")
+	if !hasMethod {
+		return nil
+	}
+
+	rfn := field.Func
+	if rfn.Type().NumOut() == 1 &&
+		rfn.Type().NumIn() == 2 &&
+		checkIsContext(rfn.Type().Out(0)) &&
+		checkIsContext(rfn.Type().In(1)) {
+		return func(ctx context.Context) context.Context {
+			rsp := rfn.Call([]reflect.Value{reflect.New(cleanPtrType(ptrType)), reflect.ValueOf(ctx)})
+			return rsp[0].Interface().(context.Context)
+		}
+	}
+
+	panicWithFootprint(
+		definitionTypePreResolver,
+		ptrType,
+		rfn.Type(),
+		errInvalidMethodSignatureForPreResolverFunction,
+	)
+	return nil
 }
 
 func (loader *manager) graphByTypes(field reflect.Type) graphql.Output {
 	cleanField := cleanPtrType(field)
 	kind := cleanField.Kind()
 
-	if scalar, ok := loader.customScalarObject[scalarNameFromType(cleanField)]; ok {
+	if scalar, ok := loader.customScalarObject[cleanField]; ok {
 		return scalar
 	}
 
+	switch cleanField {
+	case timeType:
+		return loader.dateTimeScalarObject()
+	case durationType:
+		return loader.durationScalarObject()
+	case bigIntType:
+		return loader.bigIntScalarObject()
+	case bigFloatType:
+		return loader.decimalScalarObject()
+	case rawMessageType:
+		return jsonScalarObjectFunc
+	}
+
+	if loader.isMarshalerScalarType(cleanField) {
+		if output, ok := loader.types.output(cleanField); ok {
+			return output
+		}
+		return loader.marshalerScalarObject(cleanField)
+	}
+
+	if isBytesType(cleanField) {
+		return base64ScalarObjectFunc
+	}
+
+	if kind == reflect.Interface && cleanField.NumMethod() == 0 {
+		if _, ok := loader.abstractTypes[cleanField]; !ok {
+			return jsonScalarObjectFunc
+		}
+	}
+
+	if output, ok := loader.types.output(cleanField); ok {
+		return output
+	}
+
+	if enumValues, ok := loader.enumValues[cleanField]; ok {
+		return loader.types.registerOutput(cleanField, loader.graphEnumObject(cleanField, enumValues))
+	}
+
+	if cleanField.Implements(enumerableType) {
+		enumValues := reflect.Zero(cleanField).Interface().(enumerable).EnumValues()
+		return loader.types.registerOutput(cleanField, loader.graphEnumObject(cleanField, enumValues))
+	}
+
 	switch kind {
 
 	case reflect.Bool:
 		return graphql.Boolean
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if kind == reflect.Int64 && loader.int64Format != Int64AsInt {
+			return loader.int64ScalarObject()
+		}
 		return graphql.Int
 
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if kind == reflect.Uint64 && loader.int64Format != Int64AsInt {
+			return loader.int64ScalarObject()
+		}
+		return uintScalarObjectFunc
+
 	case reflect.Float32, reflect.Float64:
 		return graphql.Float
 
@@ -295,16 +637,14 @@ func (loader *manager) graphByTypes(field reflect.Type) graphql.Output {
 		return graphql.String
 
 	case reflect.Struct:
-		safeField := cleanPtrType(field)
-		scalarName := scalarNameFromType(safeField)
-		if _, ok := loader.baseScalarObject[scalarName]; !ok {
-			_, fields := loader.graphFieldsByType(field)
-			loader.baseScalarObject[scalarName] = graphql.NewObject(graphql.ObjectConfig{
-				Name:   scalarName,
-				Fields: fields,
-			})
-		}
-		return loader.baseScalarObject[scalarName]
+		return loader.types.registerOutput(cleanField, graphql.NewObject(graphql.ObjectConfig{
+			Name:        loader.types.typeName(cleanField, false),
+			Description: loader.typeDescription(cleanField),
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return loader.graphFieldsByType(reflect.PtrTo(cleanField))
+			}),
+			Interfaces: loader.graphInterfacesByType(cleanField),
+		}))
 
 	case reflect.Slice:
 		parentType := cleanPtrType(field)
@@ -313,10 +653,10 @@ func (loader *manager) graphByTypes(field reflect.Type) graphql.Output {
 			childType = childType.Elem()
 		}
 
-		if childType.Kind() == reflect.Struct {
-			return graphql.NewList(loader.graphByTypes(childType))
+		if loader.isListElemType(childType) {
+			return graphql.NewList(loader.listElemType(parentType.Elem(), loader.graphByTypes(childType)))
 		}
-		return loader.sliceScalarObject(field, childType)
+		return loader.sliceScalarObject(childType)
 
 	case reflect.Array:
 		parentType := cleanPtrType(field)
@@ -325,13 +665,18 @@ func (loader *manager) graphByTypes(field reflect.Type) graphql.Output {
 			childType = childType.Elem()
 		}
 
-		if childType.Kind() == reflect.Struct {
-			return graphql.NewList(loader.graphByTypes(childType))
+		if loader.isListElemType(childType) {
+			return graphql.NewList(loader.listElemType(parentType.Elem(), loader.graphByTypes(childType)))
 		}
-		return loader.arrayScalarObject(field, childType)
+		return loader.arrayScalarObject(childType)
 
 	case reflect.Map:
 		return loader.mapScalarObject(field)
+
+	case reflect.Interface:
+		if abstract, ok := loader.abstractTypes[cleanField]; ok {
+			return loader.graphAbstractObject(abstract)
+		}
 	}
 
 	if field.Implements(reflect.TypeOf(new(fmt.GoStringer)).Elem()) {
@@ -341,6 +686,204 @@ func (loader *manager) graphByTypes(field reflect.Type) graphql.Output {
 	return rawScalarObjectFunc
 }
 
+func (loader *manager) isListElemType(t reflect.Type) bool {
+	if _, ok := loader.customScalarObject[t]; ok {
+		return true
+	}
+
+	if _, isEnum := loader.enumValues[t]; isEnum {
+		return true
+	}
+
+	if t.Kind() == reflect.Interface {
+		_, isAbstract := loader.abstractTypes[t]
+		return isAbstract
+	}
+	return t.Kind() == reflect.Struct || t.Implements(enumerableType) || isBuiltinScalarType(t) || loader.isMarshalerScalarType(t)
+}
+
+func (loader *manager) graphEnumObject(enumType reflect.Type, values interface{}) *graphql.Enum {
+	enumValues := reflect.ValueOf(values)
+	if enumValues.Kind() != reflect.Map || enumValues.Type().Key().Kind() != reflect.String {
+		panicWithFootprint(definitionTypeEnum, enumType, reflect.TypeOf(values), errInvalidEnumValues)
+	}
+
+	graphValues := graphql.EnumValueConfigMap{}
+	iter := enumValues.MapRange()
+	for iter.Next() {
+		value := reflect.ValueOf(iter.Value().Interface())
+		if !value.IsValid() || !value.Type().ConvertibleTo(enumType) {
+			panicWithFootprint(definitionTypeEnum, enumType, reflect.TypeOf(values), errInvalidEnumValues)
+		}
+		graphValues[iter.Key().String()] = &graphql.EnumValueConfig{Value: value.Convert(enumType).Interface()}
+	}
+
+	if deprecator, ok := reflect.Zero(enumType).Interface().(enumDeprecator); ok {
+		for name, reason := range deprecator.EnumDeprecations() {
+			graphValue, ok := graphValues[name]
+			if !ok {
+				panicWithFootprint(definitionTypeEnum, enumType, nil, errInvalidEnumDeprecation+": "+name)
+			}
+			graphValue.DeprecationReason = reason
+		}
+	}
+
+	return graphql.NewEnum(graphql.EnumConfig{
+		Name:        loader.types.typeName(enumType, false),
+		Description: loader.typeDescription(enumType),
+		Values:      graphValues,
+	})
+}
+
+func (loader *manager) graphAbstractObject(abstract *abstractDefinition) graphql.Output {
+	resolveType := func(p graphql.ResolveTypeParams) *graphql.Object {
+		valueType := cleanPtrType(reflect.TypeOf(p.Value))
+		for _, implementation := range abstract.implementations {
+			if implementation == valueType {
+				return loader.graphByTypes(implementation).(*graphql.Object)
+			}
+		}
+		return nil
+	}
+
+	if abstract.isUnion {
+		objects := make([]*graphql.Object, 0, len(abstract.implementations))
+		for _, implementation := range abstract.implementations {
+			objects = append(objects, loader.graphByTypes(implementation).(*graphql.Object))
+		}
+		return loader.types.registerOutput(abstract.abstractType, graphql.NewUnion(graphql.UnionConfig{
+			Name:        loader.types.typeName(abstract.abstractType, false),
+			Description: loader.typeDescription(abstract.abstractType),
+			Types:       objects,
+			ResolveType: resolveType,
+		}))
+	}
+
+	return loader.types.registerOutput(abstract.abstractType, graphql.NewInterface(graphql.InterfaceConfig{
+		Name:        loader.types.typeName(abstract.abstractType, false),
+		Description: loader.typeDescription(abstract.abstractType),
+		ResolveType: resolveType,
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return loader.graphInterfaceFields(abstract)
+		}),
+	}))
+}
+
+func (loader *manager) graphInterfaceFields(abstract *abstractDefinition) graphql.Fields {
+	interfaceFields := graphql.Fields{}
+	for index, implementation := range abstract.implementations {
+		objectFields := loader.graphByTypes(implementation).(*graphql.Object).Fields()
+		if index == 0 {
+			for name, definition := range objectFields {
+				args := graphql.FieldConfigArgument{}
+				for _, arg := range definition.Args {
+					args[arg.Name()] = &graphql.ArgumentConfig{
+						Type:         arg.Type,
+						DefaultValue: arg.DefaultValue,
+						Description:  arg.Description(),
+					}
+				}
+				loader.argumentDefaults[loader.types.baseName(abstract.abstractType)+"."+name] = loader.argumentDefaults[loader.types.baseName(implementation)+"."+name]
+				interfaceFields[name] = &graphql.Field{
+					Name:              name,
+					Type:              definition.Type,
+					Args:              args,
+					Description:       definition.Description,
+					DeprecationReason: definition.DeprecationReason,
+				}
+			}
+			continue
+		}
+
+		for name, field := range interfaceFields {
+			definition, ok := objectFields[name]
+			if !ok || definition.Type.String() != field.Type.String() {
+				delete(interfaceFields, name)
+			}
+		}
+	}
+	return interfaceFields
+}
+
+func (loader *manager) graphInterfacesByType(objectType reflect.Type) graphql.InterfacesThunk {
+	return func() []*graphql.Interface {
+		interfaces := make([]*graphql.Interface, 0)
+		for _, abstract := range loader.abstractList {
+			if abstract.isUnion {
+				continue
+			}
+
+			for _, implementation := range abstract.implementations {
+				if implementation == objectType {
+					interfaces = append(interfaces, loader.graphByTypes(abstract.abstractType).(*graphql.Interface))
+				}
+			}
+		}
+		return interfaces
+	}
+}
+
+func (loader *manager) graphInputByTypes(field reflect.Type) graphql.Input {
+	cleanField := cleanPtrType(field)
+	if _, ok := loader.customScalarObject[cleanField]; ok {
+		return loader.graphByTypes(field)
+	}
+
+	if isBuiltinScalarType(cleanField) || loader.isMarshalerScalarType(cleanField) {
+		return loader.graphByTypes(field)
+	}
+
+	if input, ok := loader.types.input(cleanField); ok {
+		return input
+	}
+
+	switch cleanField.Kind() {
+	case reflect.Struct:
+		return loader.types.registerInput(cleanField, graphql.NewInputObject(graphql.InputObjectConfig{
+			Name:        loader.types.typeName(cleanField, true),
+			Description: loader.typeDescription(cleanField),
+			Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+				return loader.graphInputFieldsByType(cleanField)
+			}),
+		}))
+
+	case reflect.Slice, reflect.Array:
+		childType := cleanPtrType(cleanField.Elem())
+		if loader.isListElemType(childType) {
+			return graphql.NewList(loader.listElemType(cleanField.Elem(), loader.graphInputByTypes(childType)))
+		}
+	}
+	return loader.graphByTypes(field)
+}
+
+func (loader *manager) graphInputFieldsByType(inputType reflect.Type) graphql.InputObjectConfigFieldMap {
+	inputFields := graphql.InputObjectConfigFieldMap{}
+	for j := 0; j < inputType.NumField(); j++ {
+		field := inputType.Field(j)
+		graphKey := graphNameFromTag(field.Tag, loader.graphKeyTag)
+		if graphKey == "" || graphKey == "-" {
+			continue
+		}
+
+		loader.types.nameAnonymousField(inputType, field)
+		var fieldType graphql.Input = loader.graphInputByTypes(field.Type)
+		if timeScalar, ok := loader.timeScalarByField(field); ok {
+			fieldType = timeScalar
+		}
+
+		defaultValue, hasDefault := loader.defaultValueByStructField(field)
+		if loader.isNonNullField(field) && !hasDefault {
+			fieldType = nonNullType(fieldType)
+		}
+		inputFields[graphKey] = &graphql.InputObjectFieldConfig{
+			Type:         fieldType,
+			DefaultValue: publishedDefaultValue(fieldType, defaultValue),
+			Description:  validationDescription(deprecatedDescription(loader.fieldDescription(inputType, field), loader.fieldDeprecation(inputType, field)), field),
+		}
+	}
+	return inputFields
+}
+
 func (loader *manager) mapScalarObject(t reflect.Type) graphql.Output {
 	keyType := cleanPtrType(t).Key()
 	valueType := cleanPtrType(t).Elem()
@@ -359,40 +902,10 @@ func (loader *manager) mapScalarObject(t reflect.Type) graphql.Output {
 			Name:        scalarName,
 			Description: "The `gomap_" + keyName + "_" + valueName + "` scalar type represents map[" + keyName + "]" + valueName + " data.",
 			ParseValue: func(value interface{}) interface{} {
-				mapValue := reflect.MakeMap(cleanPtrType(t))
-				if value == nil {
-					return convertToOriginalPointer(t, mapValue).Interface()
-				}
-
-				jsonString := fmt.Sprintf("%v", value)
-				jsonMap := make(map[string]interface{})
-				if err := json.Unmarshal([]byte(jsonString), &jsonMap); err != nil {
-					return convertToOriginalPointer(t, mapValue).Interface()
-				}
-
-				for key, value := range jsonMap {
-					val := reflect.ValueOf(value)
-					if val.CanConvert(valueType) {
-						mapValue.SetMapIndex(reflect.ValueOf(key), val.Convert(valueType))
-					}
-				}
-				return convertToOriginalPointer(t, mapValue).Interface()
+				return parseCollectionValue(value, new(map[string]interface{}))
 			},
 			ParseLiteral: func(valueAST ast.Value) interface{} {
-				mapValue := reflect.MakeMap(cleanPtrType(t))
-				jsonString := fmt.Sprintf("%v", valueAST.GetValue())
-				jsonMap := make(map[string]interface{})
-				if err := json.Unmarshal([]byte(jsonString), &jsonMap); err != nil {
-					return convertToOriginalPointer(t, mapValue).Interface()
-				}
-
-				for key, value := range jsonMap {
-					val := reflect.ValueOf(value)
-					if val.CanConvert(valueType) {
-						mapValue.SetMapIndex(reflect.ValueOf(key), val.Convert(valueType))
-					}
-				}
-				return convertToOriginalPointer(t, mapValue).Interface()
+				return parseCollectionLiteral(valueAST, new(map[string]interface{}))
 			},
 			Serialize: func(value interface{}) interface{} {
 				bytes, err := json.Marshal(value)
@@ -406,7 +919,7 @@ func (loader *manager) mapScalarObject(t reflect.Type) graphql.Output {
 	return loader.baseScalarObject[scalarName]
 }
 
-func (loader *manager) arrayScalarObject(t reflect.Type, childType reflect.Type) graphql.Output {
+func (loader *manager) arrayScalarObject(childType reflect.Type) graphql.Output {
 	childName := childType.Name()
 	if childName == "" {
 		childName = "interface"
@@ -417,36 +930,10 @@ func (loader *manager) arrayScalarObject(t reflect.Type, childType reflect.Type)
 			Name:        scalarName,
 			Description: "The `goarray_" + childName + "` scalar type represents [n]" + childName + " data.",
 			ParseValue: func(value interface{}) interface{} {
-				arrayValue := reflect.New(reflect.ArrayOf(cleanPtrType(t).Len(), cleanPtrType(childType))).Elem()
-				jsonString := fmt.Sprintf("%v", value)
-				jsonArray := make([]interface{}, 0)
-				if err := json.Unmarshal([]byte(jsonString), &jsonArray); err != nil {
-					return convertToOriginalPointer(t, arrayValue).Interface()
-				}
-
-				for index, value := range jsonArray {
-					val := reflect.ValueOf(value)
-					if val.CanConvert(childType) {
-						arrayValue.Index(index).Set(val.Convert(childType))
-					}
-				}
-				return convertToOriginalPointer(t, arrayValue).Interface()
+				return parseCollectionValue(value, new([]interface{}))
 			},
 			ParseLiteral: func(valueAST ast.Value) interface{} {
-				arrayValue := reflect.New(reflect.ArrayOf(cleanPtrType(t).Len(), cleanPtrType(childType))).Elem()
-				jsonString := fmt.Sprintf("%v", valueAST.GetValue())
-				jsonArray := make([]interface{}, 0)
-				if err := json.Unmarshal([]byte(jsonString), &jsonArray); err != nil {
-					return convertToOriginalPointer(t, arrayValue).Interface()
-				}
-
-				for index, value := range jsonArray {
-					val := reflect.ValueOf(value)
-					if val.CanConvert(childType) {
-						arrayValue.Index(index).Set(val.Convert(childType))
-					}
-				}
-				return convertToOriginalPointer(t, arrayValue).Interface()
+				return parseCollectionLiteral(valueAST, new([]interface{}))
 			},
 			Serialize: func(value interface{}) interface{} {
 				bytes, err := json.Marshal(value)
@@ -460,7 +947,7 @@ func (loader *manager) arrayScalarObject(t reflect.Type, childType reflect.Type)
 	return loader.baseScalarObject[scalarName]
 }
 
-func (loader *manager) sliceScalarObject(t reflect.Type, childType reflect.Type) graphql.Output {
+func (loader *manager) sliceScalarObject(childType reflect.Type) graphql.Output {
 	childName := childType.Name()
 	if childName == "" {
 		childName = "interface"
@@ -471,36 +958,10 @@ func (loader *manager) sliceScalarObject(t reflect.Type, childType reflect.Type)
 			Name:        scalarName,
 			Description: "The `goslice_" + childName + "` scalar type represents []" + childName + " data.",
 			ParseValue: func(value interface{}) interface{} {
-				sliceValue := reflect.New(reflect.SliceOf(childType)).Elem()
-				jsonString := fmt.Sprintf("%v", value)
-				jsonArray := make([]interface{}, 0)
-				if err := json.Unmarshal([]byte(jsonString), &jsonArray); err != nil {
-					return convertToOriginalPointer(t, sliceValue).Interface()
-				}
-
-				for _, value := range jsonArray {
-					val := reflect.ValueOf(value)
-					if val.CanConvert(childType) {
-						sliceValue.Set(reflect.Append(sliceValue, val.Convert(childType)))
-					}
-				}
-				return convertToOriginalPointer(t, sliceValue).Interface()
+				return parseCollectionValue(value, new([]interface{}))
 			},
 			ParseLiteral: func(valueAST ast.Value) interface{} {
-				sliceValue := reflect.New(reflect.SliceOf(childType)).Elem()
-				jsonString := fmt.Sprintf("%v", valueAST.GetValue())
-				jsonArray := make([]interface{}, 0)
-				if err := json.Unmarshal([]byte(jsonString), &jsonArray); err != nil {
-					return convertToOriginalPointer(t, sliceValue).Interface()
-				}
-
-				for _, value := range jsonArray {
-					val := reflect.ValueOf(value)
-					if val.CanConvert(childType) {
-						sliceValue.Set(reflect.Append(sliceValue, val.Convert(childType)))
-					}
-				}
-				return convertToOriginalPointer(t, sliceValue).Interface()
+				return parseCollectionLiteral(valueAST, new([]interface{}))
 			},
 			Serialize: func(value interface{}) interface{} {
 				bytes, err := json.Marshal(value)
@@ -513,3 +974,39 @@ func (loader *manager) sliceScalarObject(t reflect.Type, childType reflect.Type)
 	}
 	return loader.baseScalarObject[scalarName]
 }
+
+func parseCollectionValue(value interface{}, jsonValue interface{}) interface{} {
+	if jsonString, ok := value.(string); ok {
+		if err := json.Unmarshal([]byte(jsonString), jsonValue); err != nil {
+			return nil
+		}
+		return reflect.ValueOf(jsonValue).Elem().Interface()
+	}
+
+	val := reflect.ValueOf(value)
+	switch reflect.TypeOf(jsonValue).Elem().Kind() {
+	case reflect.Map:
+		if val.Kind() != reflect.Map {
+			return nil
+		}
+	default:
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+			return nil
+		}
+	}
+	return value
+}
+
+func parseCollectionLiteral(valueAST ast.Value, jsonValue interface{}) interface{} {
+	switch valueAST := valueAST.(type) {
+	case *ast.StringValue:
+		return parseCollectionValue(valueAST.Value, jsonValue)
+	case *ast.ListValue, *ast.ObjectValue:
+		value, err := scalarLiteralValue(valueAST)
+		if err != nil {
+			return &scalarParseError{err: err}
+		}
+		return parseCollectionValue(value, jsonValue)
+	}
+	return nil
+}
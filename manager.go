@@ -5,24 +5,45 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/graphql-go/graphql"
 )
 
 type manager struct {
 	schema             graphql.Schema
+	queryFields        graphql.Fields
+	mutationFields     graphql.Fields
+	subscriptionFields graphql.Fields
 	validator          validator
 	baseScalarObject   map[string]graphql.Output
-	customScalarObject map[string]graphql.Output
+	customScalarObject map[reflect.Type]graphql.Output
+	enumValues         map[reflect.Type]interface{}
+	abstractTypes      map[reflect.Type]*abstractDefinition
+	abstractList       []*abstractDefinition
+	types              *typeRegistry
+	docs               map[string]string
+	deprecations       map[string]string
+	converters         map[reflect.Type]converter
+	contextKeys        map[string]interface{}
+	validations        map[reflect.Type]*structValidation
+	requestValidators  map[reflect.Type][]RequestValidator
+	argumentDefaults   map[string]map[string]interface{}
+	timeLocation       *time.Location
+	int64Format        Int64Format
+	scalarParsers      map[reflect.Type]func(value interface{}) (reflect.Value, error)
+	contextValueError  func(name string) error
 	graphKeyTag        string
 	rootObjectKeyTag   string
+	inferNonNull       bool
 }
 
 type executor struct {
 	schema          graphql.Schema
 	requestString   string
-	rootObject      map[string]interface{}
+	rootObject      interface{}
 	variablesValues map[string]interface{}
 }
 
@@ -34,12 +55,61 @@ func (loader *manager) RootObjectKey(rootObjectKey string) {
 	loader.rootObjectKeyTag = rootObjectKey
 }
 
+func (loader *manager) NamingStrategy(strategy NamingStrategy) {
+	loader.types.naming = strategy
+}
+
+func (loader *manager) InferNonNull(inferNonNull bool) {
+	loader.inferNonNull = inferNonNull
+}
+
+func (loader *manager) ContextValueError(contextValueError func(name string) error) {
+	loader.contextValueError = contextValueError
+}
+
+func (loader *manager) TimeLocation(location *time.Location) {
+	loader.timeLocation = location
+}
+
+func (loader *manager) Int64Format(format Int64Format) {
+	loader.int64Format = format
+}
+
 func (loader *manager) GetSchema() graphql.Schema {
 	return loader.schema
 }
 
 func (loader *manager) RegisterSchema(resolver interface{}) error {
-	schema, err := loader.graphSchema(resolver)
+	loader.queryFields = loader.graphRootFields("Query", resolver)
+	return loader.buildSchema()
+}
+
+func (loader *manager) RegisterMutation(resolver interface{}) error {
+	loader.mutationFields = loader.graphRootFields("Mutation", resolver)
+	if loader.queryFields == nil {
+		return nil
+	}
+	return loader.buildSchema()
+}
+
+func (loader *manager) RegisterSubscription(resolver interface{}) error {
+	loader.subscriptionFields = loader.graphSubscriptionFields(resolver)
+	if loader.queryFields == nil {
+		return nil
+	}
+	return loader.buildSchema()
+}
+
+func (loader *manager) buildSchema() error {
+	if loader.types.err != nil {
+		return loader.types.err
+	}
+
+	schema, err := loader.graphSchema()
+	if loader.types.err != nil {
+		return loader.types.err
+	}
+
 	if err != nil {
 		return err
 	}
@@ -82,14 +152,44 @@ func (loader *manager) WriteMagidoc(file string, schemaFile string) error {
 	}
 	defer magidocFile.Close()
 
+	int64Placeholder := "'0'"
+	if loader.int64Format == Int64AsNumber {
+		int64Placeholder = "0"
+	}
+
+	placeholders := map[string]string{
+		"Int": "", "Float": "", "String": "", "Boolean": "", "ID": "", "RawString": "", "GoStringer": "",
+		"Uint":     "0",
+		"DateTime": "'2006-01-02T15:04:05Z'",
+		"Date":     "'2006-01-02'",
+		"Duration": "'1h0m0s'",
+		"Int64":    int64Placeholder,
+		"BigInt":   "'0'",
+		"Decimal":  "'0.0'",
+		"JSON":     "{}",
+		"Base64":   "'aGVsbG8='",
+	}
+
 	options := make([]string, 0)
-	for name := range loader.baseScalarObject {
-		if strings.HasPrefix(name, "goarray_") || strings.HasPrefix(name, "goslice_") {
-			options = append(options, fmt.Sprintf("'%v': '[]'", name))
-		} else if strings.HasPrefix(name, "gomap_") {
-			options = append(options, fmt.Sprintf("'%v': '{}'", name))
+	for name, graphType := range loader.schema.TypeMap() {
+		if _, ok := graphType.(*graphql.Scalar); !ok || strings.HasPrefix(name, "__") {
+			continue
+		}
+
+		placeholder, ok := placeholders[name]
+		switch {
+		case ok && placeholder == "":
+			continue
+		case strings.HasPrefix(name, "goarray_") || strings.HasPrefix(name, "goslice_"):
+			placeholder = "'[]'"
+		case strings.HasPrefix(name, "gomap_"):
+			placeholder = "'{}'"
+		case !ok:
+			placeholder = "''"
 		}
+		options = append(options, fmt.Sprintf("'%v': %v", name, placeholder))
 	}
+	sort.Strings(options)
 
 	configuration := fmt.Sprintf(`
 		export default {
@@ -119,7 +219,71 @@ func (loader *manager) WriteMagidoc(file string, schemaFile string) error {
 
 func (loader *manager) RegisterScalar(i interface{}, o graphql.Output) {
 	cleanType := cleanPtrType(reflect.TypeOf(i))
-	loader.customScalarObject[scalarNameFromType(cleanType)] = o
+	loader.customScalarObject[cleanType] = o
+}
+
+func (loader *manager) RegisterScalarDefinition(definition *ScalarDefinition) {
+	cleanType := cleanPtrType(definition.goType)
+	loader.customScalarObject[cleanType] = definition.scalar
+	loader.scalarParsers[cleanType] = definition.parse
+}
+
+func (loader *manager) RegisterEnum(i interface{}, values interface{}) {
+	cleanType := cleanPtrType(reflect.TypeOf(i))
+	loader.enumValues[cleanType] = values
+}
+
+func (loader *manager) RegisterInterface(i interface{}, implementations ...interface{}) {
+	loader.registerAbstract(definitionTypeInterface, false, i, implementations)
+}
+
+func (loader *manager) RegisterUnion(i interface{}, implementations ...interface{}) {
+	loader.registerAbstract(definitionTypeUnion, true, i, implementations)
+}
+
+func (loader *manager) registerAbstract(definitionType string, isUnion bool, i interface{}, implementations []interface{}) {
+	abstractType := cleanPtrType(reflect.TypeOf(i))
+	if abstractType.Kind() != reflect.Interface {
+		panicWithFootprint(definitionType, abstractType, nil, errInvalidAbstractType)
+	}
+
+	abstract := &abstractDefinition{isUnion: isUnion, abstractType: abstractType}
+	for _, implementation := range implementations {
+		implementationType := reflect.TypeOf(implementation)
+		if !implementationType.Implements(abstractType) {
+			panicWithFootprint(definitionType, implementationType, abstractType, errInvalidImplementation)
+		}
+		abstract.implementations = append(abstract.implementations, cleanPtrType(implementationType))
+	}
+
+	if _, ok := loader.abstractTypes[abstractType]; !ok {
+		loader.abstractList = append(loader.abstractList, abstract)
+	} else {
+		for index, existing := range loader.abstractList {
+			if existing.abstractType == abstractType {
+				loader.abstractList[index] = abstract
+			}
+		}
+	}
+	loader.abstractTypes[abstractType] = abstract
+}
+
+func (loader *manager) RegisterDocs(pkgPath string, dir string) error {
+	return loader.parseDocs(pkgPath, dir)
+}
+
+func (loader *manager) DeprecateMethod(i interface{}, method string, reason string) {
+	cleanType := cleanPtrType(reflect.TypeOf(i))
+	loader.deprecations[cleanType.PkgPath()+"."+cleanType.Name()+"."+method] = reason
+}
+
+func (loader *manager) RegisterContextKey(name string, key interface{}) {
+	loader.contextKeys[name] = key
+}
+
+func (loader *manager) RegisterRequestValidator(request interface{}, validator RequestValidator) {
+	requestType := cleanPtrType(reflect.TypeOf(request))
+	loader.requestValidators[requestType] = append(loader.requestValidators[requestType], validator)
 }
 
 func (loader *manager) RegisterValidator(validator validator) {
@@ -131,6 +295,17 @@ func New() *manager {
 	loader.graphKeyTag = "gql"
 	loader.rootObjectKeyTag = "root"
 	loader.baseScalarObject = make(map[string]graphql.Output)
-	loader.customScalarObject = make(map[string]graphql.Output)
+	loader.customScalarObject = make(map[reflect.Type]graphql.Output)
+	loader.enumValues = make(map[reflect.Type]interface{})
+	loader.abstractTypes = make(map[reflect.Type]*abstractDefinition)
+	loader.types = newTypeRegistry()
+	loader.docs = make(map[string]string)
+	loader.deprecations = make(map[string]string)
+	loader.converters = make(map[reflect.Type]converter)
+	loader.contextKeys = make(map[string]interface{})
+	loader.validations = make(map[reflect.Type]*structValidation)
+	loader.requestValidators = make(map[reflect.Type][]RequestValidator)
+	loader.argumentDefaults = make(map[string]map[string]interface{})
+	loader.scalarParsers = make(map[reflect.Type]func(value interface{}) (reflect.Value, error))
 	return loader
 }
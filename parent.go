@@ -0,0 +1,93 @@
+package ggl
+
+import (
+	"reflect"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type collectedField struct {
+	field      *ast.Field
+	parentType graphql.Type
+}
+
+func (loader *manager) registerArgumentDefaults(typeName string, fieldName string, method reflect.Method) {
+	if method.Type.NumIn() != 3 {
+		return
+	}
+
+	defaults := make(map[string]interface{})
+	requestType := cleanPtrType(method.Type.In(2))
+	for i := 0; i < requestType.NumField(); i++ {
+		field := requestType.Field(i)
+		graphKey := graphNameFromTag(field.Tag, loader.graphKeyTag)
+		if graphKey == "" || graphKey == "-" {
+			continue
+		}
+
+		if defaultValue, ok := loader.defaultValueByStructField(field); ok {
+			defaults[graphKey] = defaultValue
+		}
+	}
+	loader.argumentDefaults[typeName+"."+fieldName] = defaults
+}
+
+// parentArgument is resolving the arguments of ancestor fields from the operation by response path,
+// so it doesn't depend on the executor and works with `GetSchema()` as well.
+func (loader *manager) parentArgument(info graphql.ResolveInfo, name string) (interface{}, bool) {
+	operation, ok := info.Operation.(*ast.OperationDefinition)
+	if !ok || info.Path == nil {
+		return nil, false
+	}
+
+	var parentType graphql.Type = info.Schema.QueryType()
+	switch operation.Operation {
+	case ast.OperationTypeMutation:
+		parentType = info.Schema.MutationType()
+	case ast.OperationTypeSubscription:
+		parentType = info.Schema.SubscriptionType()
+	}
+
+	path := info.Path.AsArray()
+	selectionSets := []*ast.SelectionSet{operation.SelectionSet}
+	ancestorArgs := make([]map[string]interface{}, 0, len(path))
+	for _, key := range path[:len(path)-1] {
+		responseKey, ok := key.(string)
+		if !ok {
+			continue
+		}
+
+		var fields []collectedField
+		for _, collected := range loader.collectFields(info, parentType, selectionSets, false) {
+			if responseKeyOf(collected.field) == responseKey {
+				fields = append(fields, collected)
+			}
+		}
+
+		if len(fields) == 0 {
+			return nil, false
+		}
+
+		definition := selectionFieldDefinitions(fields[0].parentType)[fields[0].field.Name.Value]
+		if definition == nil {
+			return nil, false
+		}
+		ancestorArgs = append(ancestorArgs, loader.argumentValues(fields[0].parentType, definition, fields[0].field.Arguments, info.VariableValues))
+
+		selectionSets = selectionSets[:0:0]
+		for _, collected := range fields {
+			if collected.field.SelectionSet != nil {
+				selectionSets = append(selectionSets, collected.field.SelectionSet)
+			}
+		}
+		parentType = definition.Type
+	}
+
+	for index := len(ancestorArgs) - 1; index >= 0; index-- {
+		if value, ok := ancestorArgs[index][name]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
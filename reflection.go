@@ -1,6 +1,9 @@
 package ggl
 
-import "reflect"
+import (
+	"reflect"
+	"strings"
+)
 
 func convertToOriginalPointer(originalType reflect.Type, originalValue reflect.Value) reflect.Value {
 	val := originalValue
@@ -41,6 +44,73 @@ func cleanPtrType(val reflect.Type) reflect.Type {
 	}
 }
 
+func receiverValue(receiverType reflect.Type, source interface{}) reflect.Value {
+	val := reflect.ValueOf(source)
+	for val.Type() != receiverType && val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Type() != receiverType && receiverType.Kind() == reflect.Ptr && val.Type() == receiverType.Elem() {
+		ptrValue := reflect.New(val.Type())
+		ptrValue.Elem().Set(val)
+		val = ptrValue
+	}
+	return val
+}
+
 func checkIsContext(val reflect.Type) bool {
 	return val.PkgPath() == "context" && val.Name() == "Context"
 }
+
+func (loader *manager) valueByPath(source interface{}, path string) (interface{}, bool) {
+	value := reflect.ValueOf(source)
+	for _, key := range strings.Split(path, ".") {
+		for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return nil, false
+			}
+			value = value.Elem()
+		}
+
+		switch value.Kind() {
+		case reflect.Map:
+			if value.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+
+			value = value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key()))
+			if !value.IsValid() {
+				return nil, false
+			}
+
+		case reflect.Struct:
+			field, ok := loader.structFieldByKey(value.Type(), key)
+			if !ok {
+				return nil, false
+			}
+			value = value.FieldByIndex(field.Index)
+
+		default:
+			return nil, false
+		}
+	}
+
+	if !value.IsValid() || !value.CanInterface() {
+		return nil, false
+	}
+	return value.Interface(), true
+}
+
+func (loader *manager) structFieldByKey(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && graphNameFromTag(field.Tag, loader.graphKeyTag) == key {
+			return field, true
+		}
+	}
+
+	field, ok := t.FieldByNameFunc(func(name string) bool {
+		return strings.EqualFold(name, key)
+	})
+	return field, ok && field.IsExported()
+}
@@ -0,0 +1,124 @@
+package ggl
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/graphql-go/graphql"
+)
+
+type NamingStrategy func(t reflect.Type) string
+
+var (
+	invalidNameCharacters = regexp.MustCompile(`[^_0-9A-Za-z]+`)
+
+	// reservedTypeNames are the root and builtin scalar names which cannot be used by go types.
+	reservedTypeNames = []string{
+		"Query", "Mutation", "Subscription",
+		"Uint", "DateTime", "Date", "Duration", "Int64", "BigInt", "Decimal", "JSON", "Base64",
+	}
+
+	// ShortNaming is using the go type name, such as `Product`.
+	ShortNaming NamingStrategy = func(t reflect.Type) string {
+		return invalidNameCharacters.ReplaceAllString(t.Name(), "_")
+	}
+
+	// PackageNaming is using the go type name qualified by package path, such as `github_com_org_repo_Product`.
+	PackageNaming NamingStrategy = func(t reflect.Type) string {
+		return invalidNameCharacters.ReplaceAllString(scalarNameFromType(t), "_")
+	}
+)
+
+type typeDefinition struct {
+	goType  reflect.Type
+	isInput bool
+}
+
+type typeRegistry struct {
+	naming         NamingStrategy
+	outputTypes    map[reflect.Type]graphql.Output
+	inputTypes     map[reflect.Type]graphql.Input
+	typeNames      map[string]typeDefinition
+	anonymousNames map[reflect.Type]string
+	err            error
+}
+
+func newTypeRegistry() *typeRegistry {
+	registry := new(typeRegistry)
+	registry.naming = ShortNaming
+	registry.outputTypes = make(map[reflect.Type]graphql.Output)
+	registry.inputTypes = make(map[reflect.Type]graphql.Input)
+	registry.typeNames = make(map[string]typeDefinition)
+	registry.anonymousNames = make(map[reflect.Type]string)
+	for _, name := range reservedTypeNames {
+		registry.typeNames[name] = typeDefinition{}
+	}
+	return registry
+}
+
+func (registry *typeRegistry) baseName(t reflect.Type) string {
+	if name, ok := registry.anonymousNames[t]; ok {
+		return name
+	}
+	return registry.naming(t)
+}
+
+// nameAnonymousField is naming anonymous struct field by the owner type and field name, such as `ProductMeta`.
+func (registry *typeRegistry) nameAnonymousField(ownerType reflect.Type, field reflect.StructField) {
+	t := selectionElemType(field.Type)
+	if t == nil || t.Kind() != reflect.Struct || t.Name() != "" {
+		return
+	}
+
+	if _, ok := registry.anonymousNames[t]; !ok {
+		registry.anonymousNames[t] = registry.baseName(ownerType) + field.Name
+	}
+}
+
+func (registry *typeRegistry) typeName(t reflect.Type, isInput bool) string {
+	name := registry.baseName(t)
+	if isInput {
+		name += "Input"
+	}
+
+	definition := typeDefinition{goType: t, isInput: isInput}
+	if existing, ok := registry.typeNames[name]; ok && existing != definition {
+		if registry.err == nil && existing.goType == nil {
+			registry.err = fmt.Errorf(
+				"go-graph-loader: graphql type name %q of %v is reserved, use a different NamingStrategy or rename the type",
+				name, t,
+			)
+		}
+
+		if registry.err == nil {
+			registry.err = fmt.Errorf(
+				"go-graph-loader: graphql type name %q is used by both %v and %v, use a different NamingStrategy to avoid conflict",
+				name, existing.goType, t,
+			)
+		}
+		return name
+	}
+	registry.typeNames[name] = definition
+	return name
+}
+
+func (registry *typeRegistry) output(t reflect.Type) (graphql.Output, bool) {
+	output, ok := registry.outputTypes[t]
+	return output, ok
+}
+
+func (registry *typeRegistry) registerOutput(t reflect.Type, output graphql.Output) graphql.Output {
+	registry.outputTypes[t] = output
+	return output
+}
+
+func (registry *typeRegistry) input(t reflect.Type) (graphql.Input, bool) {
+	input, ok := registry.inputTypes[t]
+	return input, ok
+}
+
+func (registry *typeRegistry) registerInput(t reflect.Type, input graphql.Input) graphql.Input {
+	registry.inputTypes[t] = input
+	return input
+}
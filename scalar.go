@@ -0,0 +1,813 @@
+package ggl
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var (
+	uintScalarObjectFunc = graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "Uint",
+		Description: "The `Uint` scalar type represents non-negative integer from 0 to 2^64 - 1.",
+		Serialize: func(value interface{}) interface{} {
+			if uintValue, ok := coerceUint(value); ok {
+				return uintValue
+			}
+			return nil
+		},
+		ParseValue: func(value interface{}) interface{} {
+			if uintValue, ok := coerceUint(value); ok {
+				return uintValue
+			}
+			return nil
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			if intValue, ok := valueAST.(*ast.IntValue); ok {
+				if uintValue, err := strconv.ParseUint(intValue.Value, 10, 64); err == nil {
+					return uintValue
+				}
+			}
+			return nil
+		},
+	})
+
+	base64ScalarObjectFunc = graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "Base64",
+		Description: "The `Base64` scalar type represents binary data encoded as standard base64 string.",
+		Serialize: func(value interface{}) interface{} {
+			val := reflect.ValueOf(value)
+			for val.Kind() == reflect.Ptr {
+				if val.IsNil() {
+					return nil
+				}
+				val = val.Elem()
+			}
+
+			if val.Kind() != reflect.Slice || val.Type().Elem().Kind() != reflect.Uint8 || val.IsNil() {
+				return nil
+			}
+			return base64.StdEncoding.EncodeToString(val.Convert(reflect.TypeOf([]byte(nil))).Interface().([]byte))
+		},
+		ParseValue: func(value interface{}) interface{} {
+			if stringValue, ok := value.(string); ok {
+				return parseBase64(stringValue)
+			}
+			return nil
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			if stringValue, ok := valueAST.(*ast.StringValue); ok {
+				return parseBase64(stringValue.Value)
+			}
+			return nil
+		},
+	})
+
+	jsonScalarObjectFunc = graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "JSON",
+		Description: "The `JSON` scalar type represents arbitrary json value.",
+		Serialize: func(value interface{}) interface{} {
+			if rawMessage, ok := value.(json.RawMessage); ok {
+				if len(rawMessage) == 0 || !json.Valid(rawMessage) {
+					return nil
+				}
+				return rawMessage
+			}
+
+			bytes, err := json.Marshal(value)
+			if err != nil {
+				return nil
+			}
+			return json.RawMessage(bytes)
+		},
+		ParseValue: func(value interface{}) interface{} {
+			return value
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			value, err := scalarLiteralValue(valueAST)
+			if err != nil {
+				return &scalarParseError{err: err}
+			}
+			return value
+		},
+	})
+)
+
+func parseBase64(value string) interface{} {
+	if bytes, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return bytes
+	}
+
+	if bytes, err := base64.URLEncoding.DecodeString(value); err == nil {
+		return bytes
+	}
+	return nil
+}
+
+func coerceUint(value interface{}) (uint64, bool) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return 0, false
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return val.Uint(), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if val.Int() < 0 {
+			return 0, false
+		}
+		return uint64(val.Int()), true
+
+	case reflect.Float32, reflect.Float64:
+		floatValue := val.Float()
+		if floatValue < 0 || floatValue >= math.MaxUint64 || floatValue != math.Trunc(floatValue) {
+			return 0, false
+		}
+		return uint64(floatValue), true
+
+	case reflect.String:
+		uintValue, err := strconv.ParseUint(val.String(), 10, 64)
+		return uintValue, err == nil
+	}
+	return 0, false
+}
+
+type Int64Format string
+
+const (
+	// Int64AsInt is using graphql `Int` which only support 32-bit integer.
+	Int64AsInt Int64Format = ""
+	// Int64AsString is using `Int64` scalar serialized as string.
+	Int64AsString Int64Format = "string"
+	// Int64AsNumber is using `Int64` scalar serialized as json number.
+	Int64AsNumber Int64Format = "number"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	gqlMarshalerType    = reflect.TypeOf(new(gqlMarshaler)).Elem()
+	gqlUnmarshalerType  = reflect.TypeOf(new(gqlUnmarshaler)).Elem()
+	jsonMarshalerType   = reflect.TypeOf(new(json.Marshaler)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf(new(json.Unmarshaler)).Elem()
+	textMarshalerType   = reflect.TypeOf(new(encoding.TextMarshaler)).Elem()
+	textUnmarshalerType = reflect.TypeOf(new(encoding.TextUnmarshaler)).Elem()
+
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	durationType   = reflect.TypeOf(time.Duration(0))
+	bigIntType     = reflect.TypeOf(big.Int{})
+	bigFloatType   = reflect.TypeOf(big.Float{})
+)
+
+// scalarLiteralValue is returning the value of scalar literal, variables inside list or object literal are not
+// passed into scalar by graphql-go so it will be returned as error instead of binding null.
+func scalarLiteralValue(valueAST ast.Value) (interface{}, error) {
+	if variable := nestedVariable(valueAST); variable != nil {
+		return nil, fmt.Errorf("variable $%v cannot be used inside scalar literal, pass the whole value as variable instead", variable.Name.Value)
+	}
+	return valueFromAST(valueAST, nil), nil
+}
+
+func nestedVariable(valueAST ast.Value) *ast.Variable {
+	switch valueAST := valueAST.(type) {
+	case *ast.Variable:
+		return valueAST
+	case *ast.ListValue:
+		for _, item := range valueAST.Values {
+			if variable := nestedVariable(item); variable != nil {
+				return variable
+			}
+		}
+	case *ast.ObjectValue:
+		for _, field := range valueAST.Fields {
+			if variable := nestedVariable(field.Value); variable != nil {
+				return variable
+			}
+		}
+	}
+	return nil
+}
+
+func isBuiltinScalarType(t reflect.Type) bool {
+	switch t {
+	case timeType, durationType, bigIntType, bigFloatType, rawMessageType:
+		return true
+	}
+	return isBytesType(t)
+}
+
+func isBytesType(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && t != rawMessageType && !isMarshalerType(t)
+}
+
+func (loader *manager) location() *time.Location {
+	if loader.timeLocation != nil {
+		return loader.timeLocation
+	}
+	return time.UTC
+}
+
+func (loader *manager) dateTimeScalarObject() graphql.Output {
+	if _, ok := loader.baseScalarObject["DateTime"]; !ok {
+		parseDateTime := func(value interface{}) interface{} {
+			switch value := value.(type) {
+			case time.Time:
+				return value
+			case string:
+				if timeValue, err := time.Parse(time.RFC3339Nano, value); err == nil {
+					return timeValue
+				}
+			}
+			return nil
+		}
+
+		loader.baseScalarObject["DateTime"] = graphql.NewScalar(graphql.ScalarConfig{
+			Name:        "DateTime",
+			Description: "The `DateTime` scalar type represents date time in RFC3339 format with nanoseconds, such as `2006-01-02T15:04:05.999999999Z07:00`.",
+			Serialize: func(value interface{}) interface{} {
+				if timeValue, ok := coerceTime(value); ok {
+					if loader.timeLocation != nil {
+						timeValue = timeValue.In(loader.timeLocation)
+					}
+					return timeValue.Format(time.RFC3339Nano)
+				}
+				return nil
+			},
+			ParseValue: parseDateTime,
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				if stringValue, ok := valueAST.(*ast.StringValue); ok {
+					return parseDateTime(stringValue.Value)
+				}
+				return nil
+			},
+		})
+	}
+	return loader.baseScalarObject["DateTime"]
+}
+
+func (loader *manager) dateScalarObject() graphql.Output {
+	if _, ok := loader.baseScalarObject["Date"]; !ok {
+		parseDate := func(value interface{}) interface{} {
+			switch value := value.(type) {
+			case time.Time:
+				return value
+			case string:
+				if timeValue, err := time.ParseInLocation(dateLayout, value, loader.location()); err == nil {
+					return timeValue
+				}
+			}
+			return nil
+		}
+
+		loader.baseScalarObject["Date"] = graphql.NewScalar(graphql.ScalarConfig{
+			Name:        "Date",
+			Description: "The `Date` scalar type represents date in `2006-01-02` format.",
+			Serialize: func(value interface{}) interface{} {
+				if timeValue, ok := coerceTime(value); ok {
+					if loader.timeLocation != nil {
+						timeValue = timeValue.In(loader.timeLocation)
+					}
+					return timeValue.Format(dateLayout)
+				}
+				return nil
+			},
+			ParseValue: parseDate,
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				if stringValue, ok := valueAST.(*ast.StringValue); ok {
+					return parseDate(stringValue.Value)
+				}
+				return nil
+			},
+		})
+	}
+	return loader.baseScalarObject["Date"]
+}
+
+func (loader *manager) durationScalarObject() graphql.Output {
+	if _, ok := loader.baseScalarObject["Duration"]; !ok {
+		parseDuration := func(value interface{}) interface{} {
+			switch value := value.(type) {
+			case time.Duration:
+				return value
+			case string:
+				if durationValue, err := time.ParseDuration(value); err == nil {
+					return durationValue
+				}
+			}
+			return nil
+		}
+
+		loader.baseScalarObject["Duration"] = graphql.NewScalar(graphql.ScalarConfig{
+			Name:        "Duration",
+			Description: "The `Duration` scalar type represents go duration string, such as `1h30m` or `300ms`.",
+			Serialize: func(value interface{}) interface{} {
+				val := reflect.ValueOf(value)
+				for val.Kind() == reflect.Ptr {
+					if val.IsNil() {
+						return nil
+					}
+					val = val.Elem()
+				}
+
+				if val.Type() == durationType {
+					return val.Interface().(time.Duration).String()
+				}
+				return nil
+			},
+			ParseValue: parseDuration,
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				if stringValue, ok := valueAST.(*ast.StringValue); ok {
+					return parseDuration(stringValue.Value)
+				}
+				return nil
+			},
+		})
+	}
+	return loader.baseScalarObject["Duration"]
+}
+
+func (loader *manager) timeScalarByField(field reflect.StructField) (graphql.Output, bool) {
+	if _, ok := loader.customScalarObject[timeType]; ok {
+		return nil, false
+	}
+
+	if cleanPtrType(field.Type) == timeType && graphOptionFromTag(field.Tag, loader.graphKeyTag, "date") {
+		return loader.dateScalarObject(), true
+	}
+	return nil, false
+}
+
+func coerceTime(value interface{}) (time.Time, bool) {
+	switch value := value.(type) {
+	case time.Time:
+		return value, true
+	case *time.Time:
+		if value != nil {
+			return *value, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (loader *manager) int64ScalarObject() graphql.Output {
+	if _, ok := loader.baseScalarObject["Int64"]; !ok {
+		loader.baseScalarObject["Int64"] = graphql.NewScalar(graphql.ScalarConfig{
+			Name:        "Int64",
+			Description: "The `Int64` scalar type represents 64-bit integer, it accepts both string and number.",
+			Serialize: func(value interface{}) interface{} {
+				val := reflect.ValueOf(value)
+				for val.Kind() == reflect.Ptr {
+					if val.IsNil() {
+						return nil
+					}
+					val = val.Elem()
+				}
+
+				switch {
+				case val.CanInt() && loader.int64Format == Int64AsString:
+					return strconv.FormatInt(val.Int(), 10)
+				case val.CanInt():
+					return val.Int()
+				case val.CanUint() && loader.int64Format == Int64AsString:
+					return strconv.FormatUint(val.Uint(), 10)
+				case val.CanUint():
+					return val.Uint()
+				}
+				return nil
+			},
+			ParseValue: func(value interface{}) interface{} {
+				switch value := value.(type) {
+				case string:
+					return parseInt64(value)
+				case json.Number:
+					return parseInt64(value.String())
+				case float32, float64:
+					floatValue := reflect.ValueOf(value).Float()
+					if floatValue != math.Trunc(floatValue) || floatValue < math.MinInt64 || floatValue >= math.MaxUint64 {
+						return nil
+					}
+					return value
+				case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+					return value
+				}
+				return nil
+			},
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				switch valueAST := valueAST.(type) {
+				case *ast.IntValue:
+					return parseInt64(valueAST.Value)
+				case *ast.StringValue:
+					return parseInt64(valueAST.Value)
+				}
+				return nil
+			},
+		})
+	}
+	return loader.baseScalarObject["Int64"]
+}
+
+func parseInt64(value string) interface{} {
+	if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return intValue
+	}
+
+	if uintValue, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return uintValue
+	}
+	return nil
+}
+
+func (loader *manager) bigIntScalarObject() graphql.Output {
+	if _, ok := loader.baseScalarObject["BigInt"]; !ok {
+		loader.baseScalarObject["BigInt"] = graphql.NewScalar(graphql.ScalarConfig{
+			Name:        "BigInt",
+			Description: "The `BigInt` scalar type represents arbitrary-precision integer serialized as string.",
+			Serialize: func(value interface{}) interface{} {
+				switch value := value.(type) {
+				case *big.Int:
+					if value != nil {
+						return value.String()
+					}
+				case big.Int:
+					return value.String()
+				}
+				return nil
+			},
+			ParseValue: func(value interface{}) interface{} {
+				if bigIntValue, ok := parseBigInt(value); ok {
+					return bigIntValue
+				}
+				return nil
+			},
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				switch valueAST := valueAST.(type) {
+				case *ast.IntValue:
+					if bigIntValue, ok := parseBigInt(valueAST.Value); ok {
+						return bigIntValue
+					}
+				case *ast.StringValue:
+					if bigIntValue, ok := parseBigInt(valueAST.Value); ok {
+						return bigIntValue
+					}
+				}
+				return nil
+			},
+		})
+	}
+	return loader.baseScalarObject["BigInt"]
+}
+
+func parseBigInt(value interface{}) (*big.Int, bool) {
+	switch value := value.(type) {
+	case *big.Int:
+		return value, value != nil
+	case string:
+		return new(big.Int).SetString(value, 10)
+	case json.Number:
+		return new(big.Int).SetString(value.String(), 10)
+	case float32, float64:
+		floatValue := reflect.ValueOf(value).Float()
+		if floatValue != math.Trunc(floatValue) || math.IsInf(floatValue, 0) {
+			return nil, false
+		}
+		bigIntValue, _ := big.NewFloat(floatValue).Int(nil)
+		return bigIntValue, true
+	}
+
+	val := reflect.ValueOf(value)
+	switch {
+	case val.CanInt():
+		return big.NewInt(val.Int()), true
+	case val.CanUint():
+		return new(big.Int).SetUint64(val.Uint()), true
+	}
+	return nil, false
+}
+
+func (loader *manager) decimalScalarObject() graphql.Output {
+	if _, ok := loader.baseScalarObject["Decimal"]; !ok {
+		loader.baseScalarObject["Decimal"] = graphql.NewScalar(graphql.ScalarConfig{
+			Name:        "Decimal",
+			Description: "The `Decimal` scalar type represents arbitrary-precision decimal serialized as string.",
+			Serialize: func(value interface{}) interface{} {
+				switch value := value.(type) {
+				case *big.Float:
+					if value != nil {
+						return value.Text('f', -1)
+					}
+				case big.Float:
+					return value.Text('f', -1)
+				}
+				return nil
+			},
+			ParseValue: func(value interface{}) interface{} {
+				if bigFloatValue, ok := parseDecimal(value); ok {
+					return bigFloatValue
+				}
+				return nil
+			},
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				switch valueAST := valueAST.(type) {
+				case *ast.IntValue:
+					if bigFloatValue, ok := parseDecimal(valueAST.Value); ok {
+						return bigFloatValue
+					}
+				case *ast.FloatValue:
+					if bigFloatValue, ok := parseDecimal(valueAST.Value); ok {
+						return bigFloatValue
+					}
+				case *ast.StringValue:
+					if bigFloatValue, ok := parseDecimal(valueAST.Value); ok {
+						return bigFloatValue
+					}
+				}
+				return nil
+			},
+		})
+	}
+	return loader.baseScalarObject["Decimal"]
+}
+
+func parseDecimal(value interface{}) (*big.Float, bool) {
+	switch value := value.(type) {
+	case *big.Float:
+		return value, value != nil
+	case string:
+		return decimalFromString(value)
+	case json.Number:
+		return decimalFromString(value.String())
+	}
+
+	val := reflect.ValueOf(value)
+	switch {
+	case val.CanInt():
+		return new(big.Float).SetInt64(val.Int()), true
+	case val.CanUint():
+		return new(big.Float).SetUint64(val.Uint()), true
+	case val.CanFloat() && !math.IsInf(val.Float(), 0) && !math.IsNaN(val.Float()):
+		return big.NewFloat(val.Float()), true
+	}
+	return nil, false
+}
+
+func decimalFromString(value string) (*big.Float, bool) {
+	precision := uint(len(value))*4 + 64
+	bigFloatValue, _, err := big.ParseFloat(value, 10, precision, big.ToNearestEven)
+	return bigFloatValue, err == nil
+}
+
+var marshalerPairs = [][2]reflect.Type{
+	{gqlMarshalerType, gqlUnmarshalerType},
+	{jsonMarshalerType, jsonUnmarshalerType},
+	{textMarshalerType, textUnmarshalerType},
+}
+
+// marshalerByType is returning the first marshaler interface which the type implements with its unmarshaler pair.
+func marshalerByType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Interface || t.Kind() == reflect.Ptr {
+		return nil
+	}
+
+	ptrType := reflect.PtrTo(t)
+	for _, pair := range marshalerPairs {
+		if !t.Implements(pair[0]) && !ptrType.Implements(pair[0]) {
+			continue
+		}
+
+		if ptrType.Implements(pair[1]) {
+			return pair[0]
+		}
+	}
+	return nil
+}
+
+func isMarshalerType(t reflect.Type) bool {
+	return marshalerByType(t) != nil
+}
+
+// isMarshalerScalarType is excluding struct models which declared gql fields or field resolvers,
+// so types implementing marshaler for other purpose like rest response still become object.
+func (loader *manager) isMarshalerScalarType(t reflect.Type) bool {
+	if isBuiltinScalarType(t) || !isMarshalerType(t) {
+		return false
+	}
+
+	if _, isEnum := loader.enumValues[t]; isEnum || t.Implements(enumerableType) {
+		return false
+	}
+
+	if t.Kind() != reflect.Struct {
+		return true
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if graphNameFromTag(t.Field(i).Tag, loader.graphKeyTag) != "" {
+			return false
+		}
+	}
+
+	ptrType := reflect.PtrTo(t)
+	for i := 0; i < ptrType.NumMethod(); i++ {
+		if strings.HasPrefix(ptrType.Method(i).Name, "GGL_") {
+			return false
+		}
+	}
+	return true
+}
+
+func (loader *manager) marshalerScalarObject(t reflect.Type) graphql.Output {
+	parseValue := func(value interface{}) interface{} {
+		val, err := parseMarshalerValue(t, value)
+		if err != nil {
+			return nil
+		}
+		return val.Interface()
+	}
+
+	return loader.types.registerOutput(t, graphql.NewScalar(graphql.ScalarConfig{
+		Name:        loader.types.typeName(t, false),
+		Description: loader.typeDescription(t),
+		Serialize: func(value interface{}) interface{} {
+			serialized, err := serializeMarshalerValue(t, value)
+			if err != nil {
+				return nil
+			}
+			return serialized
+		},
+		ParseValue: parseValue,
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			value, err := scalarLiteralValue(valueAST)
+			if err != nil {
+				return &scalarParseError{err: err}
+			}
+			return parseValue(value)
+		},
+	}))
+}
+
+func serializeMarshalerValue(t reflect.Type, value interface{}) (interface{}, error) {
+	val := reflect.ValueOf(value)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, nil
+		}
+		val = val.Elem()
+	}
+
+	if val.Type() != t {
+		return nil, fmt.Errorf("unexpected %v value for %v", val.Type(), t)
+	}
+
+	ptrValue := reflect.New(t)
+	ptrValue.Elem().Set(val)
+	switch marshalerByType(t) {
+	case gqlMarshalerType:
+		return ptrValue.Interface().(gqlMarshaler).MarshalGQL()
+	case jsonMarshalerType:
+		bytes, err := ptrValue.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(bytes), nil
+	case textMarshalerType:
+		bytes, err := ptrValue.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return string(bytes), nil
+	}
+	return nil, fmt.Errorf("%v is not implementing marshaler", t)
+}
+
+func parseMarshalerValue(t reflect.Type, value interface{}) (reflect.Value, error) {
+	ptrValue := reflect.New(t)
+	switch marshalerByType(t) {
+	case gqlMarshalerType:
+		if err := ptrValue.Interface().(gqlUnmarshaler).UnmarshalGQL(value); err != nil {
+			return reflect.Value{}, err
+		}
+
+	case jsonMarshalerType:
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		if err := ptrValue.Interface().(json.Unmarshaler).UnmarshalJSON(bytes); err != nil {
+			return reflect.Value{}, err
+		}
+
+	case textMarshalerType:
+		stringValue, ok := value.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("expected string value for %v", t)
+		}
+
+		if err := ptrValue.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(stringValue)); err != nil {
+			return reflect.Value{}, err
+		}
+
+	default:
+		return reflect.Value{}, fmt.Errorf("%v is not implementing unmarshaler", t)
+	}
+	return ptrValue.Elem(), nil
+}
+
+// ScalarDefinition is the type-safe custom scalar created by `Scalar`, register it with `RegisterScalarDefinition`.
+type ScalarDefinition struct {
+	goType reflect.Type
+	scalar *graphql.Scalar
+	parse  func(value interface{}) (reflect.Value, error)
+}
+
+type scalarParseError struct {
+	err error
+}
+
+// Scalar is creating custom scalar for go type T, T can be pointer or non-pointer type.
+func Scalar[T any](name string, serialize func(T) (any, error), parse func(any) (T, error)) *ScalarDefinition {
+	goType := reflect.TypeOf(new(T)).Elem()
+	parseValue := func(value interface{}) (reflect.Value, error) {
+		parsed, err := parse(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(&parsed).Elem(), nil
+	}
+
+	scalarParse := func(value interface{}) interface{} {
+		parsed, err := parseValue(value)
+		if err != nil {
+			return &scalarParseError{err: err}
+		}
+		return parsed.Interface()
+	}
+
+	return &ScalarDefinition{
+		goType: goType,
+		parse:  parseValue,
+		scalar: graphql.NewScalar(graphql.ScalarConfig{
+			Name: name,
+			Serialize: func(value interface{}) interface{} {
+				val, ok := scalarValueOf(goType, value)
+				if !ok {
+					return nil
+				}
+
+				serialized, err := serialize(val.Interface().(T))
+				if err != nil {
+					return nil
+				}
+				return serialized
+			},
+			ParseValue: scalarParse,
+			ParseLiteral: func(valueAST ast.Value) interface{} {
+				value, err := scalarLiteralValue(valueAST)
+				if err != nil {
+					return &scalarParseError{err: err}
+				}
+				return scalarParse(value)
+			},
+		}),
+	}
+}
+
+func scalarValueOf(t reflect.Type, value interface{}) (reflect.Value, bool) {
+	val := reflect.ValueOf(value)
+	if !val.IsValid() {
+		return val, false
+	}
+
+	for val.Type() != t && val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return val, false
+		}
+		val = val.Elem()
+	}
+
+	if val.Type() != t && t.Kind() == reflect.Ptr && val.Type() == t.Elem() {
+		ptrValue := reflect.New(val.Type())
+		ptrValue.Elem().Set(val)
+		val = ptrValue
+	}
+
+	if val.Type() != t || (val.Kind() == reflect.Ptr && val.IsNil()) {
+		return val, false
+	}
+	return val, true
+}
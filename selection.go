@@ -0,0 +1,384 @@
+package ggl
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var selectionType = reflect.TypeOf(Selection{})
+
+// Selection is the requested child fields of current field, it will be filled when declared in request struct.
+type Selection struct {
+	Fields []*SelectedField
+}
+
+// SelectedField is the requested field with fragments and aliases expanded.
+type SelectedField struct {
+	Name      string
+	Alias     string
+	GoField   string
+	Arguments map[string]interface{}
+	Selection *Selection
+}
+
+func (selection *Selection) Field(name string) *SelectedField {
+	if selection == nil {
+		return nil
+	}
+
+	for _, field := range selection.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func (selection *Selection) Has(name string) bool {
+	return selection.Field(name) != nil
+}
+
+func (selection *Selection) GoFields() []string {
+	goFields := make([]string, 0)
+	if selection == nil {
+		return goFields
+	}
+
+	exists := make(map[string]bool)
+	for _, field := range selection.Fields {
+		if field.GoField != "" && !exists[field.GoField] {
+			exists[field.GoField] = true
+			goFields = append(goFields, field.GoField)
+		}
+	}
+	return goFields
+}
+
+func (loader *manager) selectionByInfo(goType reflect.Type, info graphql.ResolveInfo) *Selection {
+	selectionSets := make([]*ast.SelectionSet, 0, len(info.FieldASTs))
+	for _, fieldAST := range info.FieldASTs {
+		selectionSets = append(selectionSets, fieldAST.SelectionSet)
+	}
+	return loader.selectionBySets(goType, info.ReturnType, selectionSets, info)
+}
+
+func (loader *manager) selectionBySets(goType reflect.Type, graphType graphql.Type, selectionSets []*ast.SelectionSet, info graphql.ResolveInfo) *Selection {
+	selection := new(Selection)
+	fields := make(map[string]*SelectedField)
+	children := make(map[string][]*ast.SelectionSet)
+	goType = selectionElemType(goType)
+	fieldDefinitions := selectionFieldDefinitions(graphType)
+
+	for _, collected := range loader.collectFields(info, graphType, selectionSets, true) {
+		selected := collected.field
+		responseKey := responseKeyOf(selected)
+		if _, ok := fields[responseKey]; !ok {
+			field := &SelectedField{
+				Name:      selected.Name.Value,
+				Alias:     responseKey,
+				Arguments: loader.argumentValues(graphType, fieldDefinitions[selected.Name.Value], selected.Arguments, info.VariableValues),
+			}
+			if goType != nil && goType.Kind() == reflect.Struct {
+				field.GoField = loader.goFieldByGraphName(goType, field.Name)
+			}
+			fields[responseKey] = field
+			selection.Fields = append(selection.Fields, field)
+		}
+		if selected.SelectionSet != nil {
+			children[responseKey] = append(children[responseKey], selected.SelectionSet)
+		}
+	}
+
+	for _, field := range selection.Fields {
+		if len(children[field.Alias]) == 0 {
+			continue
+		}
+
+		var childGoType reflect.Type
+		if field.GoField != "" {
+			if structField, ok := goType.FieldByName(field.GoField); ok {
+				childGoType = structField.Type
+			}
+		}
+
+		var childGraphType graphql.Type
+		if definition, ok := fieldDefinitions[field.Name]; ok {
+			childGraphType = definition.Type
+		}
+		field.Selection = loader.selectionBySets(childGoType, childGraphType, children[field.Alias], info)
+	}
+	return selection
+}
+
+func (loader *manager) goFieldByGraphName(t reflect.Type, name string) string {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && graphNameFromTag(field.Tag, loader.graphKeyTag) == name {
+			return field.Name
+		}
+	}
+	return ""
+}
+
+func selectionElemType(t reflect.Type) reflect.Type {
+	for t != nil {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Chan:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+	return nil
+}
+
+func selectionFieldDefinitions(graphType graphql.Type) graphql.FieldDefinitionMap {
+	if graphType == nil {
+		return nil
+	}
+
+	switch namedType := graphql.GetNamed(graphType).(type) {
+	case *graphql.Object:
+		return namedType.Fields()
+	case *graphql.Interface:
+		return namedType.Fields()
+	}
+	return nil
+}
+
+func shouldIncludeSelection(directives []*ast.Directive, variables map[string]interface{}) bool {
+	for _, directive := range directives {
+		for _, argument := range directive.Arguments {
+			if argument.Name.Value != "if" {
+				continue
+			}
+
+			condition, _ := valueFromAST(argument.Value, variables).(bool)
+			switch directive.Name.Value {
+			case "skip":
+				if condition {
+					return false
+				}
+			case "include":
+				if !condition {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func valueFromAST(value ast.Value, variables map[string]interface{}) interface{} {
+	switch value := value.(type) {
+	case *ast.Variable:
+		return variables[value.Name.Value]
+	case *ast.IntValue:
+		intValue, err := strconv.ParseInt(value.Value, 10, 64)
+		if err != nil {
+			return value.Value
+		}
+		return int(intValue)
+	case *ast.FloatValue:
+		floatValue, err := strconv.ParseFloat(value.Value, 64)
+		if err != nil {
+			return value.Value
+		}
+		return floatValue
+	case *ast.ListValue:
+		listValue := make([]interface{}, 0, len(value.Values))
+		for _, item := range value.Values {
+			listValue = append(listValue, valueFromAST(item, variables))
+		}
+		return listValue
+	case *ast.ObjectValue:
+		objectValue := make(map[string]interface{})
+		for _, field := range value.Fields {
+			objectValue[field.Name.Value] = valueFromAST(field.Value, variables)
+		}
+		return objectValue
+	case nil:
+		return nil
+	}
+	return value.GetValue()
+}
+
+func responseKeyOf(field *ast.Field) string {
+	if field.Alias != nil {
+		return field.Alias.Value
+	}
+	return field.Name.Value
+}
+
+// collectFields is expanding the fragments of selection sets, when strict only the fragments matching the parent type
+// will be expanded, otherwise fragments of possible types are expanded with the type condition as parent type.
+func (loader *manager) collectFields(info graphql.ResolveInfo, parentType graphql.Type, selectionSets []*ast.SelectionSet, strict bool) []collectedField {
+	fields := make([]collectedField, 0)
+	var collect func(selectionSet *ast.SelectionSet, parentType graphql.Type)
+	collect = func(selectionSet *ast.SelectionSet, parentType graphql.Type) {
+		if selectionSet == nil {
+			return
+		}
+
+		for _, selected := range selectionSet.Selections {
+			switch selected := selected.(type) {
+			case *ast.Field:
+				if shouldIncludeSelection(selected.Directives, info.VariableValues) {
+					fields = append(fields, collectedField{field: selected, parentType: parentType})
+				}
+
+			case *ast.InlineFragment:
+				if !shouldIncludeSelection(selected.Directives, info.VariableValues) {
+					continue
+				}
+
+				if fragmentType, ok := fragmentParentType(info.Schema, parentType, selected.TypeCondition, strict); ok {
+					collect(selected.SelectionSet, fragmentType)
+				}
+
+			case *ast.FragmentSpread:
+				if !shouldIncludeSelection(selected.Directives, info.VariableValues) {
+					continue
+				}
+
+				fragment, ok := info.Fragments[selected.Name.Value].(*ast.FragmentDefinition)
+				if !ok {
+					continue
+				}
+
+				if fragmentType, ok := fragmentParentType(info.Schema, parentType, fragment.TypeCondition, strict); ok {
+					collect(fragment.SelectionSet, fragmentType)
+				}
+			}
+		}
+	}
+
+	for _, selectionSet := range selectionSets {
+		collect(selectionSet, parentType)
+	}
+	return fields
+}
+
+func fragmentParentType(schema graphql.Schema, parentType graphql.Type, typeCondition *ast.Named, strict bool) (graphql.Type, bool) {
+	if typeCondition == nil || parentType == nil {
+		return parentType, true
+	}
+
+	parentNamed := graphql.GetNamed(parentType)
+	if parentNamed.String() == typeCondition.Name.Value {
+		return parentType, true
+	}
+
+	conditionType := schema.Type(typeCondition.Name.Value)
+	if object, ok := parentNamed.(*graphql.Object); ok {
+		switch abstractType := conditionType.(type) {
+		case *graphql.Interface, *graphql.Union:
+			for _, possibleType := range schema.PossibleTypes(abstractType.(graphql.Abstract)) {
+				if possibleType.Name() == object.Name() {
+					return parentType, true
+				}
+			}
+		}
+	}
+
+	if strict || conditionType == nil {
+		return nil, false
+	}
+	return conditionType, true
+}
+
+func (loader *manager) argumentValues(parentType graphql.Type, definition *graphql.FieldDefinition, arguments []*ast.Argument, variables map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	if definition == nil {
+		for _, argument := range arguments {
+			values[argument.Name.Value] = valueFromAST(argument.Value, variables)
+		}
+		return values
+	}
+
+	argumentASTs := make(map[string]ast.Value)
+	for _, argument := range arguments {
+		argumentASTs[argument.Name.Value] = argument.Value
+	}
+
+	defaults := loader.argumentDefaults[graphql.GetNamed(parentType).String()+"."+definition.Name]
+	for _, argument := range definition.Args {
+		var value interface{}
+		if valueAST, ok := argumentASTs[argument.Name()]; ok {
+			value = coerceValueFromAST(valueAST, argument.Type, variables)
+		}
+
+		if value == nil {
+			value = defaults[argument.Name()]
+		}
+
+		if value != nil {
+			values[argument.Name()] = value
+		}
+	}
+	return values
+}
+
+// coerceValueFromAST is coercing the value with input type same as graphql-go does for field arguments.
+func coerceValueFromAST(value ast.Value, t graphql.Input, variables map[string]interface{}) interface{} {
+	if variable, ok := value.(*ast.Variable); ok {
+		return variables[variable.Name.Value]
+	}
+
+	switch t := t.(type) {
+	case *graphql.NonNull:
+		return coerceValueFromAST(value, t.OfType, variables)
+
+	case *graphql.List:
+		listValue := make([]interface{}, 0)
+		if value, ok := value.(*ast.ListValue); ok {
+			for _, item := range value.Values {
+				listValue = append(listValue, coerceValueFromAST(item, t.OfType, variables))
+			}
+			return listValue
+		}
+		return append(listValue, coerceValueFromAST(value, t.OfType, variables))
+
+	case *graphql.InputObject:
+		objectAST, ok := value.(*ast.ObjectValue)
+		if !ok {
+			return nil
+		}
+
+		fieldASTs := make(map[string]ast.Value)
+		for _, field := range objectAST.Fields {
+			fieldASTs[field.Name.Value] = field.Value
+		}
+
+		objectValue := make(map[string]interface{})
+		for name, field := range t.Fields() {
+			var fieldValue interface{} = field.DefaultValue
+			if fieldAST, ok := fieldASTs[name]; ok {
+				fieldValue = coerceValueFromAST(fieldAST, field.Type, variables)
+			}
+
+			if literal, ok := fieldValue.(defaultLiteral); ok {
+				fieldValue = literal.value
+			}
+
+			if fieldValue != nil {
+				objectValue[name] = fieldValue
+			}
+		}
+		return objectValue
+
+	case *graphql.Scalar:
+		parsed := t.ParseLiteral(value)
+		if _, ok := parsed.(*scalarParseError); ok {
+			return nil
+		}
+		return parsed
+
+	case *graphql.Enum:
+		return t.ParseLiteral(value)
+	}
+	return nil
+}
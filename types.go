@@ -1,13 +1,59 @@
 package ggl
 
-import "context"
+import (
+	"context"
+	"reflect"
+
+	"github.com/graphql-go/graphql"
+)
 
 type validator interface {
 	Validate(i interface{}) error
 }
 
+type gqlMarshaler interface {
+	MarshalGQL() (interface{}, error)
+}
+
+type gqlUnmarshaler interface {
+	UnmarshalGQL(value interface{}) error
+}
+
+type contextValidator interface {
+	Validate(ctx context.Context) error
+}
+
+// RequestValidator is validating the request struct with context and resolver info, request will be pointer of request struct.
+type RequestValidator func(ctx context.Context, request interface{}, info graphql.ResolveInfo) error
+
+const (
+	descriptionKeyTag = "desc"
+	deprecatedKeyTag  = "deprecated"
+	defaultKeyTag     = "default"
+	contextKeyTag     = "ctx"
+	parentArgKeyTag   = "parentArg"
+	sourceKeyTag      = "source"
+	validateKeyTag    = "validate"
+)
+
+type abstractDefinition struct {
+	isUnion         bool
+	abstractType    reflect.Type
+	implementations []reflect.Type
+}
+
+type enumerable interface {
+	EnumValues() map[string]interface{}
+}
+
+type enumDeprecator interface {
+	EnumDeprecations() map[string]string
+}
+
 type resolver func(context.Context) context.Context
 
+type rootObjectContextKey struct{}
+
 const introspectionQuery = `
   query IntrospectionQuery {
     __schema {
@@ -0,0 +1,354 @@
+package ggl
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+type validationRule struct {
+	name    string
+	param   string
+	message string
+	check   func(value reflect.Value) bool
+}
+
+type fieldValidation struct {
+	index      int
+	name       string
+	argument   bool
+	hasDefault bool
+	required   bool
+	rules      []validationRule
+	nested     *structValidation
+}
+
+type structValidation struct {
+	fields []fieldValidation
+}
+
+type fieldValidationError struct {
+	path    []interface{}
+	rule    string
+	param   string
+	message string
+}
+
+func (err *fieldValidationError) Error() string {
+	path := make([]string, 0, len(err.path))
+	for _, key := range err.path {
+		path = append(path, fmt.Sprintf("%v", key))
+	}
+	return fmt.Sprintf("go-graph-loader: %s %s", strings.Join(path, "."), err.message)
+}
+
+func (err *fieldValidationError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"code": errorCodeValidationFailed,
+		"path": err.path,
+		"rule": err.rule,
+	}
+
+	if len(err.path) > 0 {
+		extensions["argument"] = err.path[0]
+	}
+
+	if err.param != "" {
+		extensions["param"] = err.param
+	}
+	return extensions
+}
+
+type multiError []error
+
+func (errs multiError) Error() string {
+	if len(errs) == 0 {
+		return ""
+	}
+	return errs[0].Error()
+}
+
+// Extensions is using the first violation and listing every violation in `errors`, so the violations still
+// available when the errors are formatted by graphql-go instead of executor.
+func (errs multiError) Extensions() map[string]interface{} {
+	extensions := make(map[string]interface{})
+	violations := make([]map[string]interface{}, 0, len(errs))
+	for index, err := range errs {
+		violation := map[string]interface{}{"message": err.Error()}
+		if extendedErr, ok := err.(gqlerrors.ExtendedError); ok {
+			violation["extensions"] = extendedErr.Extensions()
+			if index == 0 {
+				for key, value := range extendedErr.Extensions() {
+					extensions[key] = value
+				}
+			}
+		}
+		violations = append(violations, violation)
+	}
+	extensions["errors"] = violations
+	return extensions
+}
+
+func appendValidationError(errs multiError, err error) multiError {
+	switch err := err.(type) {
+	case nil:
+		return errs
+	case multiError:
+		return append(errs, err...)
+	case gqlerrors.ExtendedError:
+		return append(errs, err)
+	case interface{ Unwrap() []error }:
+		for _, itemErr := range err.Unwrap() {
+			errs = appendValidationError(errs, itemErr)
+		}
+		return errs
+	}
+	return append(errs, &validationError{err: err})
+}
+
+func validationDescription(description string, field reflect.StructField) string {
+	rules := field.Tag.Get(validateKeyTag)
+	if rules == "" {
+		return description
+	}
+
+	if description == "" {
+		return "Validation: " + rules
+	}
+	return description + "\n\nValidation: " + rules
+}
+
+func (loader *manager) validationByType(t reflect.Type) *structValidation {
+	t = cleanPtrType(t)
+	if validation, ok := loader.validations[t]; ok {
+		return validation
+	}
+
+	validation := new(structValidation)
+	loader.validations[t] = validation
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || cleanPtrType(field.Type) == selectionType {
+			continue
+		}
+
+		fieldValidation := fieldValidation{index: i, name: field.Name}
+		if graphKey := graphNameFromTag(field.Tag, loader.graphKeyTag); graphKey != "" && graphKey != "-" {
+			fieldValidation.name = graphKey
+			fieldValidation.argument = true
+			_, fieldValidation.hasDefault = loader.defaultValueByStructField(field)
+		}
+
+		if rules, ok := field.Tag.Lookup(validateKeyTag); ok {
+			fieldValidation.required, fieldValidation.rules = parseValidationRules(t, rules)
+		}
+
+		elemType := selectionElemType(field.Type)
+		if elemType.Kind() == reflect.Struct && elemType != timeType {
+			fieldValidation.nested = loader.validationByType(elemType)
+		}
+
+		if fieldValidation.required || len(fieldValidation.rules) > 0 || fieldValidation.nested != nil {
+			validation.fields = append(validation.fields, fieldValidation)
+		}
+	}
+	return validation
+}
+
+func parseValidationRules(t reflect.Type, tag string) (bool, []validationRule) {
+	required := false
+	rules := make([]validationRule, 0)
+	for tag != "" {
+		rule := tag
+		if !strings.HasPrefix(tag, "regex=") {
+			if index := strings.Index(tag, ","); index >= 0 {
+				rule = tag[:index]
+				tag = tag[index+1:]
+			} else {
+				tag = ""
+			}
+		} else {
+			tag = ""
+		}
+
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "" {
+			continue
+		}
+
+		if name == "required" {
+			required = true
+			continue
+		}
+
+		validationRule, err := newValidationRule(name, param)
+		if err != nil {
+			panicWithFootprint(
+				definitionTypeValidation,
+				t,
+				nil,
+				errInvalidValidationRule+": "+err.Error(),
+			)
+		}
+		rules = append(rules, validationRule)
+	}
+	return required, rules
+}
+
+func newValidationRule(name string, param string) (validationRule, error) {
+	rule := validationRule{name: name, param: param}
+	switch name {
+	case "min", "max", "len":
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return rule, fmt.Errorf("%v=%v expected number", name, param)
+		}
+
+		switch name {
+		case "min":
+			rule.message = "must be at least " + param
+			rule.check = func(value reflect.Value) bool {
+				size, ok := validationSize(value)
+				return !ok || size >= limit
+			}
+		case "max":
+			rule.message = "must be at most " + param
+			rule.check = func(value reflect.Value) bool {
+				size, ok := validationSize(value)
+				return !ok || size <= limit
+			}
+		case "len":
+			rule.message = "must have length of " + param
+			rule.check = func(value reflect.Value) bool {
+				size, ok := validationLength(value)
+				return !ok || size == limit
+			}
+		}
+
+	case "oneof":
+		options := strings.Fields(param)
+		if len(options) == 0 {
+			return rule, fmt.Errorf("oneof expected at least one option")
+		}
+
+		rule.message = "must be one of [" + strings.Join(options, ", ") + "]"
+		rule.check = func(value reflect.Value) bool {
+			current := fmt.Sprintf("%v", value.Interface())
+			for _, option := range options {
+				if current == option {
+					return true
+				}
+			}
+			return false
+		}
+
+	case "regex":
+		pattern, err := regexp.Compile(param)
+		if err != nil {
+			return rule, err
+		}
+
+		rule.message = "must match " + param
+		rule.check = func(value reflect.Value) bool {
+			return value.Kind() != reflect.String || pattern.MatchString(value.String())
+		}
+
+	default:
+		return rule, fmt.Errorf("unknown rule %v", name)
+	}
+	return rule, nil
+}
+
+func validationPath(path []interface{}, key interface{}) []interface{} {
+	fieldPath := make([]interface{}, 0, len(path)+1)
+	return append(append(fieldPath, path...), key)
+}
+
+func validationSize(value reflect.Value) (float64, bool) {
+	switch {
+	case value.CanInt():
+		return float64(value.Int()), true
+	case value.CanUint():
+		return float64(value.Uint()), true
+	case value.CanFloat():
+		return value.Float(), true
+	}
+	return validationLength(value)
+}
+
+func validationLength(value reflect.Value) (float64, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(value.String())), true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(value.Len()), true
+	}
+	return 0, false
+}
+
+// validate is checking the binded value against the rules, the input is the supplied graphql value so
+// the rules are skipped only when the argument is omitted and not an explicit zero value.
+func (validation *structValidation) validate(value reflect.Value, input interface{}, path []interface{}) multiError {
+	errs := make(multiError, 0)
+	value = cleanPtrValue(value)
+	if !value.IsValid() {
+		return errs
+	}
+
+	inputObject, isInputObject := input.(map[string]interface{})
+	for _, field := range validation.fields {
+		fieldPath := validationPath(path, field.name)
+		fieldInput, supplied := inputObject[field.name]
+		omitted := isInputObject && field.argument && !supplied && !field.hasDefault
+		fieldValue := value.Field(field.index)
+		for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+			fieldValue = fieldValue.Elem()
+		}
+
+		if fieldValue.Kind() == reflect.Ptr || fieldValue.IsZero() {
+			if field.required {
+				errs = append(errs, &fieldValidationError{path: fieldPath, rule: "required", message: "is required"})
+				continue
+			}
+
+			if fieldValue.Kind() == reflect.Ptr || omitted {
+				continue
+			}
+		}
+
+		for _, rule := range field.rules {
+			if !rule.check(fieldValue) {
+				errs = append(errs, &fieldValidationError{
+					path:    fieldPath,
+					rule:    rule.name,
+					param:   rule.param,
+					message: rule.message,
+				})
+			}
+		}
+
+		if field.nested == nil {
+			continue
+		}
+
+		switch fieldValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			inputList, _ := fieldInput.([]interface{})
+			for index := 0; index < fieldValue.Len(); index++ {
+				var elemInput interface{}
+				if index < len(inputList) {
+					elemInput = inputList[index]
+				}
+				errs = append(errs, field.nested.validate(fieldValue.Index(index), elemInput, validationPath(fieldPath, index))...)
+			}
+		case reflect.Struct:
+			errs = append(errs, field.nested.validate(fieldValue, fieldInput, fieldPath)...)
+		}
+	}
+	return errs
+}